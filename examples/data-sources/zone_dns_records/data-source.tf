@@ -0,0 +1,10 @@
+# Addresses of the office hosts, maintained by another team
+data "zone_dns_records" "office" {
+  zone       = "example.com"
+  types      = ["A", "AAAA"]
+  name_regex = "^office-[0-9]+\\."
+}
+
+output "office_allowlist" {
+  value = [for r in data.zone_dns_records.office.records : r.destination]
+}
@@ -9,3 +9,7 @@ output "zone_active" {
 output "zone_ipv6" {
   value = data.zone_dns_zone.main.ipv6
 }
+
+output "zone_resource_url" {
+  value = data.zone_dns_zone.main.resource_url
+}
@@ -0,0 +1,9 @@
+data "zone_dns_zone_file" "example" {
+  zone = "example.com"
+  ttl  = 3600
+}
+
+resource "local_file" "zone" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.zone_dns_zone_file.example.content
+}
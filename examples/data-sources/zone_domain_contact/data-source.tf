@@ -0,0 +1,8 @@
+data "zone_domain_contact" "registrant" {
+  domain = "example.com"
+  role   = "registrant"
+}
+
+output "registrant_name" {
+  value = data.zone_domain_contact.registrant.name
+}
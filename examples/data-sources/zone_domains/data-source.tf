@@ -0,0 +1,15 @@
+data "zone_domains" "expiring" {
+  expiring_within_days = 30
+  delegated            = false
+}
+
+output "expiring_domains" {
+  value = data.zone_domains.expiring.names
+}
+
+resource "zone_domain" "all" {
+  for_each = toset(data.zone_domains.expiring.names)
+
+  name      = each.value
+  autorenew = true
+}
@@ -0,0 +1,9 @@
+data "zone_mail_account_app_passwords" "stale" {
+  service         = "example.com"
+  address         = "info@example.com"
+  unused_for_days = 90
+}
+
+output "stale_app_passwords" {
+  value = data.zone_mail_account_app_passwords.stale.app_passwords
+}
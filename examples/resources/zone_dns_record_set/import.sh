@@ -0,0 +1 @@
+terraform import zone_dns_record_set.www example.com/A/www.example.com
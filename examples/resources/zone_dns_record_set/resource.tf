@@ -0,0 +1,23 @@
+# Round-robin A records
+resource "zone_dns_record_set" "www" {
+  zone = "example.com"
+  name = "www.example.com"
+  type = "A"
+
+  values = [
+    "192.0.2.1",
+    "192.0.2.2",
+  ]
+}
+
+# All MX records of the zone apex
+resource "zone_dns_record_set" "mx" {
+  zone = "example.com"
+  name = "example.com"
+  type = "MX"
+
+  values = [
+    "10 mx1.example.com",
+    "20 mx2.example.com",
+  ]
+}
@@ -0,0 +1 @@
+terraform import zone_dns_zone.example example.com
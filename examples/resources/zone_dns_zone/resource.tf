@@ -0,0 +1,5 @@
+resource "zone_dns_zone" "example" {
+  name   = "example.com"
+  active = true
+  ipv6   = true
+}
@@ -0,0 +1 @@
+terraform import zone_dns_zone_records.example example.com
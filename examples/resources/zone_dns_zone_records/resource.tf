@@ -0,0 +1,27 @@
+resource "zone_dns_zone_records" "example" {
+  zone = "example.com"
+
+  records = [
+    {
+      type        = "A"
+      name        = "example.com"
+      destination = "192.168.1.1"
+    },
+    {
+      type        = "CNAME"
+      name        = "www.example.com"
+      destination = "example.com"
+    },
+    {
+      type        = "MX"
+      name        = "example.com"
+      destination = "mail.example.com"
+      priority    = 10
+    },
+    {
+      type        = "TXT"
+      name        = "example.com"
+      destination = "v=spf1 include:_spf.example.com ~all"
+    },
+  ]
+}
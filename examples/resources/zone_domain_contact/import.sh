@@ -0,0 +1 @@
+terraform import zone_domain_contact.admin example.com/12345
@@ -0,0 +1,20 @@
+resource "zone_domain_contact" "admin" {
+  domain = "example.com"
+  role   = "admin"
+
+  name    = "Jane Doe"
+  email   = "hostmaster@example.com"
+  voice   = "+372.5555555"
+  country = "EE"
+  city    = "Tallinn"
+  street  = "Example tn 1"
+
+  postalcode = "10111"
+}
+
+# The account's default tech contact
+resource "zone_domain_contact" "tech" {
+  domain      = "example.com"
+  role        = "tech"
+  use_default = true
+}
@@ -0,0 +1,14 @@
+data "zone_domain" "example" {
+  name = "example.com"
+}
+
+# Renews the domain for one year. A new order is placed whenever the expiry
+# date changes, so uncomment the triggers to renew again after each renewal.
+resource "zone_domain_renewal" "example" {
+  domain = data.zone_domain.example.name
+  period = 1
+
+  # triggers = {
+  #   expires = data.zone_domain.example.expires
+  # }
+}
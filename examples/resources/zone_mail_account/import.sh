@@ -0,0 +1 @@
+terraform import zone_mail_account.info example.com/info@example.com
@@ -0,0 +1,13 @@
+variable "info_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zone_mail_account" "info" {
+  service       = "example.com"
+  address       = "info@example.com"
+  password      = var.info_password
+  comment       = "Shared inbox"
+  fwd_addresses = ["archive@example.net"]
+  spamlevel     = "medium"
+}
@@ -0,0 +1,13 @@
+data "zone_mail_account_app_passwords" "stale" {
+  service         = "example.com"
+  address         = "info@example.com"
+  unused_for_days = 90
+}
+
+# Revokes every application password unused for 90 days. Passwords that
+# become stale later are revoked by the next apply.
+resource "zone_mail_account_app_password_revocation" "stale" {
+  service = data.zone_mail_account_app_passwords.stale.service
+  address = data.zone_mail_account_app_passwords.stale.address
+  ids     = data.zone_mail_account_app_passwords.stale.ids
+}
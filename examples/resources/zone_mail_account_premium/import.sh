@@ -0,0 +1 @@
+terraform import zone_mail_account_premium.info example.com/info@example.com
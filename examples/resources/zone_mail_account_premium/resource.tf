@@ -0,0 +1,5 @@
+resource "zone_mail_account_premium" "info" {
+  service = "example.com"
+  address = "info@example.com"
+  package = "premium-50"
+}
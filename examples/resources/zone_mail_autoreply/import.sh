@@ -0,0 +1 @@
+terraform import zone_mail_autoreply.holidays example.com/forwarder/sales@example.com
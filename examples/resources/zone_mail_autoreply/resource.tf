@@ -0,0 +1,10 @@
+resource "zone_mail_autoreply" "holidays" {
+  service   = "example.com"
+  kind      = "forwarder"
+  address   = zone_mail_forwarder.sales.address
+  fromname  = "Example Sales"
+  subject   = "Closed for the holidays"
+  body      = "We are back on January 5th."
+  datestart = "2026-12-20"
+  dateend   = "2027-01-04"
+}
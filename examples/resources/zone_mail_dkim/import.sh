@@ -0,0 +1 @@
+terraform import zone_mail_dkim.example example.com
@@ -0,0 +1,13 @@
+resource "zone_mail_dkim" "example" {
+  service           = "example.com"
+  manage_dns_record = true
+
+  # Change to rotate the key; the TXT record follows
+  triggers = {
+    rotated = "2026-10"
+  }
+}
+
+output "dkim_record" {
+  value = "${zone_mail_dkim.example.txt_name} TXT \"${zone_mail_dkim.example.txt_value}\""
+}
@@ -0,0 +1 @@
+terraform import zone_mail_forwarder.sales example.com/sales@example.com
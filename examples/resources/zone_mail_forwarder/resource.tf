@@ -0,0 +1,6 @@
+resource "zone_mail_forwarder" "sales" {
+  service       = "example.com"
+  address       = "sales@example.com"
+  comment       = "Sales team"
+  fwd_addresses = ["jane@example.com", "john@example.com"]
+}
@@ -0,0 +1 @@
+terraform import zone_mysql_database.shop example.com/d12345_shop
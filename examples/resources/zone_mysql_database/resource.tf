@@ -0,0 +1,10 @@
+resource "zone_mysql_database" "shop" {
+  service   = "example.com"
+  name      = "shop"
+  comment   = "Web shop"
+  collation = "utf8mb4_unicode_ci"
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
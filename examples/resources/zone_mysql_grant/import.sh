@@ -0,0 +1 @@
+terraform import zone_mysql_grant.shop example.com/d12345_shop/d12345_shop
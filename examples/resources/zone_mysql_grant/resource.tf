@@ -0,0 +1,6 @@
+resource "zone_mysql_grant" "shop" {
+  service     = "example.com"
+  username    = zone_mysql_user.shop.full_username
+  database    = zone_mysql_database.shop.full_name
+  permissions = ["SELECT", "INSERT", "UPDATE", "DELETE"]
+}
@@ -0,0 +1 @@
+terraform import zone_mysql_user.shop example.com/d12345_shop
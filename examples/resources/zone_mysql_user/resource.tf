@@ -0,0 +1,12 @@
+variable "shop_db_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zone_mysql_user" "shop" {
+  service  = "example.com"
+  username = "shop"
+  password = var.shop_db_password
+  comment  = "Web shop application"
+  hosts    = ["ws"]
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zone-eu/terraform-provider-zone/internal/provider"
+)
+
+// zoneFlags collects repeated --zone flags
+type zoneFlags []string
+
+func (z *zoneFlags) String() string {
+	return strings.Join(*z, ",")
+}
+
+func (z *zoneFlags) Set(value string) error {
+	for _, zone := range strings.Split(value, ",") {
+		if zone = strings.TrimSpace(zone); zone != "" {
+			*z = append(*z, zone)
+		}
+	}
+	return nil
+}
+
+// runGenerate implements the generate command, which writes Terraform
+// configuration and import blocks for existing zones.
+func runGenerate(args []string) error {
+	var (
+		zones  zoneFlags
+		output string
+	)
+
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.Var(&zones, "zone", "zone to generate configuration for (repeatable or comma separated)")
+	fs.StringVar(&output, "output", "", "file to write the configuration to (default stdout)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s generate --zone example.com [--zone example.org] [--output imported.tf]\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Credentials are read from the ZONE_EU_USERNAME and ZONE_EU_API_KEY environment variables.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(zones) == 0 {
+		fs.Usage()
+		return errors.New("at least one --zone is required")
+	}
+
+	username := os.Getenv("ZONE_EU_USERNAME")
+	apiKey := os.Getenv("ZONE_EU_API_KEY")
+	if username == "" || apiKey == "" {
+		return errors.New("ZONE_EU_USERNAME and ZONE_EU_API_KEY must be set")
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return provider.GenerateConfig(context.Background(), provider.NewClient(username, apiKey), w, zones)
+}
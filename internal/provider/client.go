@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,39 +18,88 @@ import (
 )
 
 const (
-	baseURL = "https://api.zone.eu/v2"
+	defaultBaseURL = "https://api.zone.eu/v2"
 
 	// Rate limiting constants
 	defaultRateLimit     = 60 // requests per minute
 	rateLimitResetPeriod = time.Minute
+	rateLimitBurst       = 5 // requests that may be sent back to back
 	maxRetries           = 3
 	retryBaseDelay       = time.Second
+	retryMaxWait         = 30 * time.Second
+
+	// Pagination: the API defaults to 10 items per page, 100 is the maximum
+	pagerMaxLimit = 100
+
+	// recordCacheTTL is how long a listing of a zone's records of one type is
+	// used to serve reads
+	recordCacheTTL = 30 * time.Second
 )
 
 // Client represents the Zone.EU API client
 type Client struct {
 	httpClient *http.Client
+	baseURL    string
 	username   string
 	apiKey     string
 
-	// Rate limiting
+	// Rate limiting: requests are paced by a token bucket refilled at
+	// requestsPerMinute (or the lower X-Ratelimit-Limit), which is shared by
+	// all resources using this client
 	mu                 sync.Mutex
+	requestsPerMinute  int
+	tokens             float64
+	lastRefill         time.Time
 	rateLimitLimit     int
 	rateLimitRemaining int
 	rateLimitResetAt   time.Time
+
+	// Retries
+	maxRetries   int
+	retryMaxWait time.Duration
+
+	// Record listings keyed by zone and record type, see DNSRecordsClient.List
+	cacheMu     sync.Mutex
+	recordCache map[string]*recordCacheEntry
 }
 
 // NewClient creates a new Zone.EU API client
 func NewClient(username, apiKey string) *Client {
 	return &Client{
 		httpClient:         &http.Client{Timeout: 30 * time.Second},
+		baseURL:            defaultBaseURL,
 		username:           username,
 		apiKey:             apiKey,
+		requestsPerMinute:  defaultRateLimit,
+		tokens:             rateLimitBurst,
+		lastRefill:         time.Now(),
 		rateLimitLimit:     defaultRateLimit,
 		rateLimitRemaining: defaultRateLimit,
+		maxRetries:         maxRetries,
+		retryMaxWait:       retryMaxWait,
+		recordCache:        make(map[string]*recordCacheEntry),
 	}
 }
 
+// SetRequestsPerMinute sets the rate at which requests are sent. The rate is
+// capped by X-Ratelimit-Limit (60 per minute per IP until the API reports
+// otherwise), so this is mostly useful to go slower when several Terraform
+// runs share an IP address.
+func (c *Client) SetRequestsPerMinute(rpm int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requestsPerMinute = rpm
+	c.tokens = min(c.tokens, c.burst())
+}
+
+// SetRetryPolicy sets how many times a failed request is retried and the
+// maximum delay between attempts
+func (c *Client) SetRetryPolicy(retries int, maxWait time.Duration) {
+	c.maxRetries = retries
+	c.retryMaxWait = maxWait
+}
+
 // authHeader returns the Basic Auth header value
 func (c *Client) authHeader() string {
 	auth := c.username + ":" + c.apiKey
@@ -80,7 +132,28 @@ func parseDNSZoneResponse(resp []byte) (*DNSZone, error) {
 	return &zones[0], nil
 }
 
-// updateRateLimitInfo updates rate limit info from response headers
+// apiTimeLayouts are the formats the API has been seen to use for dates and
+// timestamps, such as the expires field of a domain or the last use of an
+// application password
+var apiTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseAPITime parses a date or timestamp returned by the API
+func parseAPITime(value string) (time.Time, error) {
+	for _, layout := range apiTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
+// updateRateLimitInfo updates rate limit info from response headers. The
+// bucket never holds more tokens than the API says are remaining, so requests
+// made by other clients from the same IP are accounted for.
 func (c *Client) updateRateLimitInfo(resp *http.Response) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -94,27 +167,57 @@ func (c *Client) updateRateLimitInfo(resp *http.Response) {
 	if remaining := resp.Header.Get("X-Ratelimit-Remaining"); remaining != "" {
 		if val, err := strconv.Atoi(remaining); err == nil {
 			c.rateLimitRemaining = val
+			c.tokens = min(c.tokens, float64(val))
 		}
 	}
 }
 
-// waitForRateLimit waits if we've hit the rate limit
-func (c *Client) waitForRateLimit() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	// If we have remaining requests, no need to wait
-	if c.rateLimitRemaining > 0 {
-		return
+// rate returns the number of requests per minute the bucket is refilled with.
+// Must be called with c.mu held.
+func (c *Client) rate() int {
+	rate := c.requestsPerMinute
+	if c.rateLimitLimit > 0 && c.rateLimitLimit < rate {
+		rate = c.rateLimitLimit
 	}
+	return max(rate, 1)
+}
 
-	// Calculate wait time until reset
-	now := time.Now()
-	if c.rateLimitResetAt.After(now) {
-		waitDuration := c.rateLimitResetAt.Sub(now)
-		c.mu.Unlock()
-		time.Sleep(waitDuration)
+// burst returns the bucket capacity. Must be called with c.mu held.
+func (c *Client) burst() float64 {
+	return float64(min(rateLimitBurst, c.rate()))
+}
+
+// waitForRateLimit blocks until the token bucket allows another request, or
+// until the Retry-After period of a 429 response has passed
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	for {
 		c.mu.Lock()
+		now := time.Now()
+
+		var wait time.Duration
+		if c.rateLimitResetAt.After(now) {
+			wait = c.rateLimitResetAt.Sub(now)
+		} else {
+			perToken := rateLimitResetPeriod / time.Duration(c.rate())
+			if elapsed := now.Sub(c.lastRefill); elapsed > 0 {
+				c.tokens = min(c.tokens+float64(elapsed)/float64(perToken), c.burst())
+				c.lastRefill = now
+			}
+
+			if c.tokens >= 1 {
+				c.tokens--
+				c.mu.Unlock()
+				return nil
+			}
+			wait = time.Duration((1 - c.tokens) * float64(perToken))
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
 	}
 }
 
@@ -126,42 +229,154 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 
 // doRequestWithContext performs an HTTP request with authentication, rate limiting, and context support
 func (c *Client) doRequestWithContext(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	respBody, _, err := c.doRequestWithHeaders(ctx, method, path, body, nil)
+	return respBody, err
+}
+
+// doRequestWithHeaders performs an HTTP request with additional request headers
+// and returns the response headers along with the body. Rate limited requests,
+// 409 conflicts (concurrent requests) and, for idempotent methods, server
+// errors and network failures are retried with jittered exponential backoff.
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, body interface{}, headers http.Header) ([]byte, http.Header, error) {
 	var lastErr error
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := c.retryDelay(attempt, lastErr)
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
 		// Check for context cancellation
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 		}
 
-		// Wait if we've hit rate limit
-		c.waitForRateLimit()
+		// Wait for the rate limiter
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, nil, err
+		}
 
-		result, err := c.doRequestOnce(ctx, method, path, body)
+		result, respHeader, err := c.doRequestOnce(ctx, method, path, body, headers)
 		if err == nil {
-			return result, nil
+			return result, respHeader, nil
 		}
 
 		// Check if it's a rate limit error
 		if rateLimitErr, ok := err.(*RateLimitError); ok {
-			// Set reset time and wait
+			// Set reset time, retryDelay waits until then
 			c.mu.Lock()
 			c.rateLimitRemaining = 0
+			c.tokens = 0
 			c.rateLimitResetAt = time.Now().Add(rateLimitErr.RetryAfter)
+			c.lastRefill = c.rateLimitResetAt
 			c.mu.Unlock()
+		} else if ctx.Err() != nil || !isRetryable(method, err) {
+			return nil, nil, err
+		}
 
-			lastErr = err
-			time.Sleep(rateLimitErr.RetryAfter)
-			continue
+		lastErr = err
+	}
+
+	return nil, nil, fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// isRetryable reports whether a failed request can safely be sent again
+func isRetryable(method string, err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.StatusCode == http.StatusConflict {
+			return true
 		}
+		return apiErr.StatusCode >= 500 && isIdempotent(method)
+	}
 
-		// For other errors, return immediately
-		return nil, err
+	// Connection refused, reset, timeouts, ...
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && isIdempotent(method)
+}
+
+// isIdempotent reports whether repeating a request has the same effect as
+// sending it once. POST creates a new resource every time.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the given retry attempt:
+// exponential backoff from retryBaseDelay with full jitter, capped at
+// retryMaxWait. Rate limited requests wait for the advertised Retry-After.
+func (c *Client) retryDelay(attempt int, lastErr error) time.Duration {
+	if rateLimitErr, ok := lastErr.(*RateLimitError); ok {
+		return rateLimitErr.RetryAfter
+	}
+
+	delay := retryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > c.retryMaxWait {
+		delay = c.retryMaxWait
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Jitter between half and the full delay spreads out parallel retries
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// ListOptions controls the sort order of list requests. Sortable fields are
+// described per endpoint in the API documentation.
+type ListOptions struct {
+	OrderBy  string // x-order-by
+	OrderDir string // x-order-dir: "asc" or "desc"
+}
+
+// doListRequest performs a GET request on a collection endpoint. If the API
+// pages the response (x-pager-enabled), all pages are fetched at the maximum
+// page size and returned as a single JSON array.
+func (c *Client) doListRequest(ctx context.Context, path string, opts *ListOptions) ([]byte, error) {
+	items := make([]json.RawMessage, 0)
+
+	for page := 1; ; page++ {
+		headers := http.Header{}
+		headers.Set("X-Pager-Page", strconv.Itoa(page))
+		headers.Set("X-Pager-Limit", strconv.Itoa(pagerMaxLimit))
+		if opts != nil && opts.OrderBy != "" {
+			headers.Set("X-Order-By", opts.OrderBy)
+			if opts.OrderDir != "" {
+				headers.Set("X-Order-Dir", opts.OrderDir)
+			}
+		}
+
+		respBody, respHeader, err := c.doRequestWithHeaders(ctx, "GET", path, nil, headers)
+		if err != nil {
+			return nil, err
+		}
+
+		// Not paged - the response already contains everything
+		if enabled := respHeader.Get("X-Pager-Enabled"); enabled == "" || enabled == "0" {
+			return respBody, nil
+		}
+
+		var pageItems []json.RawMessage
+		if err := json.Unmarshal(respBody, &pageItems); err != nil {
+			return nil, fmt.Errorf("error parsing response: %w", err)
+		}
+		items = append(items, pageItems...)
+
+		pages, err := strconv.Atoi(respHeader.Get("X-Pager-Pages"))
+		if err != nil || page >= pages || len(pageItems) == 0 {
+			break
+		}
 	}
 
-	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
+	return json.Marshal(items)
 }
 
 // RateLimitError represents a rate limit error from the API
@@ -174,29 +389,148 @@ func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("rate limit exceeded, retry after %v: %s", e.RetryAfter, e.Message)
 }
 
+// APIError is returned for unsuccessful (non-2xx) API responses
+type APIError struct {
+	StatusCode int
+	// StatusMessage is the human readable X-Status-Message header
+	StatusMessage string
+	// Messages are the general error messages of an ErrorResponse body
+	Messages []string
+	// FieldErrors are validation errors keyed by resource field, e.g.
+	// {"name": "zone_conflict"}
+	FieldErrors map[string]string
+	// Body is the raw response body
+	Body string
+}
+
+func (e *APIError) Error() string {
+	msg := e.Body
+	if e.StatusMessage != "" {
+		if msg != "" {
+			msg = fmt.Sprintf("%s (X-Status-Message: %s)", msg, e.StatusMessage)
+		} else {
+			msg = e.StatusMessage
+		}
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, msg)
+}
+
+// hasFieldError reports whether any field failed validation with code
+func (e *APIError) hasFieldError(code string) bool {
+	for _, v := range e.FieldErrors {
+		if v == code {
+			return true
+		}
+	}
+	return false
+}
+
+// newAPIError builds an APIError from a response, parsing error bodies of the
+// form {"messages": [...]} and field-keyed validation errors such as
+// {"destination": "invalid"}. The body may also be wrapped in an array.
+func newAPIError(statusCode int, header http.Header, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode:    statusCode,
+		StatusMessage: header.Get("X-Status-Message"),
+		Body:          string(body),
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		var wrapped []map[string]json.RawMessage
+		if err := json.Unmarshal(body, &wrapped); err != nil || len(wrapped) == 0 {
+			return apiErr
+		}
+		fields = wrapped[0]
+	}
+
+	for field, raw := range fields {
+		if field == "messages" {
+			_ = json.Unmarshal(raw, &apiErr.Messages)
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			var values []string
+			if err := json.Unmarshal(raw, &values); err != nil {
+				continue
+			}
+			value = strings.Join(values, ", ")
+		}
+		if apiErr.FieldErrors == nil {
+			apiErr.FieldErrors = make(map[string]string)
+		}
+		apiErr.FieldErrors[field] = value
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is an API 404 response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is a conflict: either a 409 response or a
+// validation error because the resource already exists (zone_conflict)
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusConflict || apiErr.hasFieldError("zone_conflict")
+}
+
+// IsValidation reports whether err is an API 422 validation response
+func IsValidation(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity
+}
+
+// IsPaymentRequired reports whether err is an API 402 response, which is
+// mostly returned when a package upgrade is required
+func IsPaymentRequired(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPaymentRequired
+}
+
+// IsNotSupported reports whether err is an API 400 response, which the API
+// uses for operations that are not supported for the resource
+func IsNotSupported(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest
+}
+
 // doRequestOnce performs a single HTTP request
-func (c *Client) doRequestOnce(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+func (c *Client) doRequestOnce(ctx context.Context, method, path string, body interface{}, headers http.Header) ([]byte, http.Header, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling request body: %w", err)
+			return nil, nil, fmt.Errorf("error marshaling request body: %w", err)
 		}
 		bodyReader = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Authorization", c.authHeader())
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	for name, values := range headers {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error performing request: %w", err)
+		return nil, nil, fmt.Errorf("error performing request: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -205,7 +539,7 @@ func (c *Client) doRequestOnce(ctx context.Context, method, path string, body in
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
+		return nil, nil, fmt.Errorf("error reading response body: %w", err)
 	}
 
 	// Handle rate limiting (429 Too Many Requests)
@@ -217,7 +551,7 @@ func (c *Client) doRequestOnce(ctx context.Context, method, path string, body in
 			}
 		}
 		statusMsg := resp.Header.Get("X-Status-Message")
-		return nil, &RateLimitError{
+		return nil, nil, &RateLimitError{
 			RetryAfter: retryAfter,
 			Message:    statusMsg,
 		}
@@ -225,15 +559,10 @@ func (c *Client) doRequestOnce(ctx context.Context, method, path string, body in
 
 	// Handle other error status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		statusMsg := resp.Header.Get("X-Status-Message")
-		errMsg := string(respBody)
-		if statusMsg != "" {
-			errMsg = fmt.Sprintf("%s (X-Status-Message: %s)", errMsg, statusMsg)
-		}
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, errMsg)
+		return nil, nil, newAPIError(resp.StatusCode, resp.Header, respBody)
 	}
 
-	return respBody, nil
+	return respBody, resp.Header, nil
 }
 
 // DNSRecord represents a generic DNS record
@@ -256,152 +585,128 @@ type DNSRecord struct {
 	// For SSHFP records (algorithm, type) and URL records (type=redirect code)
 	Algorithm int `json:"algorithm,omitempty"`
 	Type      int `json:"type,omitempty"`
+	// Read-only: false for records managed by Zone.EU that cannot be deleted
+	Deletable *bool `json:"delete,omitempty"`
 }
 
 // DNSZone represents a DNS zone
 type DNSZone struct {
-	Name   string `json:"name"`
-	Active bool   `json:"active"`
-	IPv6   bool   `json:"ipv6"`
-}
-
-// GetZone retrieves zone information
-func (c *Client) GetZone(zone string) (*DNSZone, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/dns/%s", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var z DNSZone
-	if err := json.Unmarshal(resp, &z); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return &z, nil
+	ResourceURL   string `json:"resource_url,omitempty"`
+	Identificator string `json:"identificator,omitempty"`
+	Name          string `json:"name"`
+	Active        bool   `json:"active"`
+	IPv6          bool   `json:"ipv6"`
 }
 
-// ==================== A Records ====================
-
-// ListARecords retrieves all A records for a zone
-func (c *Client) ListARecords(zone string) ([]DNSRecord, error) {
-	return c.ListARecordsWithContext(context.Background(), zone)
+// DNSZoneUpdate represents the updateable fields for a DNS zone
+type DNSZoneUpdate struct {
+	Active *bool `json:"active,omitempty"`
+	IPv6   *bool `json:"ipv6,omitempty"`
 }
 
-func (c *Client) ListARecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/a", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return records, nil
-}
+// ==================== DNS Records ====================
 
-// FindARecordByName finds an A record by name in a zone
-func (c *Client) FindARecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindARecordByNameWithContext(context.Background(), zone, name)
+// DNSRecordsClient performs operations on the DNS records of a single type.
+// All record types share the same endpoints and payload shape, differing only
+// in the path segment (/dns/{zone}/a, /dns/{zone}/mx, ...).
+type DNSRecordsClient struct {
+	client     *Client
+	recordType string
 }
 
-func (c *Client) FindARecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListARecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
+// Records returns a client for DNS records of the given type (e.g. "A", "MX")
+func (c *Client) Records(recordType string) *DNSRecordsClient {
+	return &DNSRecordsClient{
+		client:     c,
+		recordType: strings.ToLower(recordType),
 	}
-	return nil, nil // Not found
 }
 
-// FindAllARecordsByName finds ALL A records with matching name in a zone
-func (c *Client) FindAllARecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllARecordsByNameWithContext(context.Background(), zone, name)
+func (rc *DNSRecordsClient) collectionPath(zone string) string {
+	return fmt.Sprintf("/dns/%s/%s", zone, rc.recordType)
 }
 
-func (c *Client) FindAllARecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListARecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
-	}
-	return matches, nil
+func (rc *DNSRecordsClient) recordPath(zone, id string) string {
+	return fmt.Sprintf("/dns/%s/%s/%s", zone, rc.recordType, id)
 }
 
-func (c *Client) GetARecord(zone, id string) (*DNSRecord, error) {
-	return c.GetARecordWithContext(context.Background(), zone, id)
+// recordCacheEntry is a (possibly still loading) listing of records
+type recordCacheEntry struct {
+	done    chan struct{} // closed once records and err are set
+	records []DNSRecord
+	err     error
+	fetched time.Time
 }
 
-func (c *Client) GetARecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/a/%s", zone, id), nil)
-	if err != nil {
-		return nil, err
+// usable reports whether the entry is loading or holds a recent listing
+func (e *recordCacheEntry) usable() bool {
+	select {
+	case <-e.done:
+		return e.err == nil && time.Since(e.fetched) < recordCacheTTL
+	default:
+		return true
 	}
-	return parseDNSRecordResponse(resp)
 }
 
-func (c *Client) CreateARecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateARecordWithContext(context.Background(), zone, record)
+func (rc *DNSRecordsClient) cacheKey(zone string) string {
+	return zone + "/" + rc.recordType
 }
 
-func (c *Client) CreateARecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/a", zone), record)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
+// invalidate drops the cached listing of the zone, called on every write
+func (rc *DNSRecordsClient) invalidate(zone string) {
+	rc.client.cacheMu.Lock()
+	defer rc.client.cacheMu.Unlock()
+	delete(rc.client.recordCache, rc.cacheKey(zone))
 }
 
-func (c *Client) UpdateARecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateARecordWithContext(context.Background(), zone, id, record)
-}
+// List retrieves all records of this type in a zone. Listings are cached for
+// a short time and shared between concurrent callers, so refreshing many
+// records of a zone costs one request per record type instead of one per
+// record. Create, Update and Delete invalidate the cache.
+func (rc *DNSRecordsClient) List(ctx context.Context, zone string) ([]DNSRecord, error) {
+	key := rc.cacheKey(zone)
 
-func (c *Client) UpdateARecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/a/%s", zone, id), record)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
-}
+	for {
+		rc.client.cacheMu.Lock()
+		entry, ok := rc.client.recordCache[key]
+		if !ok || !entry.usable() {
+			entry = &recordCacheEntry{done: make(chan struct{})}
+			rc.client.recordCache[key] = entry
+			rc.client.cacheMu.Unlock()
 
-func (c *Client) DeleteARecord(zone, id string) error {
-	return c.DeleteARecordWithContext(context.Background(), zone, id)
-}
+			// Sort by name to keep page boundaries stable
+			entry.records, entry.err = rc.ListWithOptions(ctx, zone, &ListOptions{OrderBy: "name", OrderDir: "asc"})
+			entry.fetched = time.Now()
+			close(entry.done)
+		} else {
+			rc.client.cacheMu.Unlock()
+		}
 
-func (c *Client) DeleteARecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/a/%s", zone, id), nil)
-	return err
-}
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 
-// ==================== AAAA Records ====================
+		// The request was made with the context of another caller that has
+		// been cancelled since, try again with ours
+		if entry.err != nil && errors.Is(entry.err, context.Canceled) && ctx.Err() == nil {
+			continue
+		}
+		if entry.err != nil {
+			return nil, entry.err
+		}
 
-// ListAAAARecords retrieves all AAAA records for a zone
-func (c *Client) ListAAAARecords(zone string) ([]DNSRecord, error) {
-	return c.ListAAAARecordsWithContext(context.Background(), zone)
+		records := make([]DNSRecord, len(entry.records))
+		copy(records, entry.records)
+		return records, nil
+	}
 }
 
-func (c *Client) ListAAAARecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/aaaa", zone), nil)
+// ListWithOptions retrieves all records of this type in a zone in the given
+// order, bypassing the cache
+func (rc *DNSRecordsClient) ListWithOptions(ctx context.Context, zone string, opts *ListOptions) ([]DNSRecord, error) {
+	resp, err := rc.client.doListRequest(ctx, rc.collectionPath(zone), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -412,1325 +717,1086 @@ func (c *Client) ListAAAARecordsWithContext(ctx context.Context, zone string) ([
 	return records, nil
 }
 
-// FindAAAARecordByName finds an AAAA record by name in a zone
-func (c *Client) FindAAAARecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindAAAARecordByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindAAAARecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListAAAARecordsWithContext(ctx, zone)
+// FindByName finds the first record with a matching name in a zone.
+// Returns nil without an error if there is no such record.
+func (rc *DNSRecordsClient) FindByName(ctx context.Context, zone, name string) (*DNSRecord, error) {
+	records, err := rc.List(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
 	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
+		if recordNameMatches(zone, r.Name, name) {
 			return &r, nil
 		}
 	}
 	return nil, nil // Not found
 }
 
-// FindAllAAAARecordsByName finds ALL AAAA records with matching name in a zone
-func (c *Client) FindAllAAAARecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllAAAARecordsByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindAllAAAARecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListAAAARecordsWithContext(ctx, zone)
+// FindAllByName finds ALL records with a matching name in a zone
+func (rc *DNSRecordsClient) FindAllByName(ctx context.Context, zone, name string) ([]DNSRecord, error) {
+	records, err := rc.List(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
 	var matches []DNSRecord
 	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
+		if recordNameMatches(zone, r.Name, name) {
 			matches = append(matches, r)
 		}
 	}
 	return matches, nil
 }
 
-func (c *Client) GetAAAARecord(zone, id string) (*DNSRecord, error) {
-	return c.GetAAAARecordWithContext(context.Background(), zone, id)
-}
+// Get retrieves a single record by ID. The record is served from the cached
+// listing of the zone when possible; records missing from the listing are
+// requested individually.
+func (rc *DNSRecordsClient) Get(ctx context.Context, zone, id string) (*DNSRecord, error) {
+	if records, err := rc.List(ctx, zone); err == nil {
+		for _, r := range records {
+			if r.ID == id {
+				return &r, nil
+			}
+		}
+	}
 
-func (c *Client) GetAAAARecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/aaaa/%s", zone, id), nil)
+	resp, err := rc.client.doRequestWithContext(ctx, "GET", rc.recordPath(zone, id), nil)
 	if err != nil {
 		return nil, err
 	}
 	return parseDNSRecordResponse(resp)
 }
 
-func (c *Client) CreateAAAARecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateAAAARecordWithContext(context.Background(), zone, record)
-}
+// Create creates a new record
+func (rc *DNSRecordsClient) Create(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
+	defer rc.invalidate(zone)
 
-func (c *Client) CreateAAAARecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/aaaa", zone), record)
+	resp, err := rc.client.doRequestWithContext(ctx, "POST", rc.collectionPath(zone), record)
 	if err != nil {
 		return nil, err
 	}
 	return parseDNSRecordResponse(resp)
 }
 
-func (c *Client) UpdateAAAARecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateAAAARecordWithContext(context.Background(), zone, id, record)
-}
+// Update updates an existing record
+func (rc *DNSRecordsClient) Update(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
+	defer rc.invalidate(zone)
 
-func (c *Client) UpdateAAAARecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/aaaa/%s", zone, id), record)
+	resp, err := rc.client.doRequestWithContext(ctx, "PUT", rc.recordPath(zone, id), record)
 	if err != nil {
 		return nil, err
 	}
 	return parseDNSRecordResponse(resp)
 }
 
-func (c *Client) DeleteAAAARecord(zone, id string) error {
-	return c.DeleteAAAARecordWithContext(context.Background(), zone, id)
-}
+// Delete deletes a record
+func (rc *DNSRecordsClient) Delete(ctx context.Context, zone, id string) error {
+	defer rc.invalidate(zone)
 
-func (c *Client) DeleteAAAARecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/aaaa/%s", zone, id), nil)
+	_, err := rc.client.doRequestWithContext(ctx, "DELETE", rc.recordPath(zone, id), nil)
 	return err
 }
 
-// ==================== CNAME Records ====================
-
-// ListCNAMERecords retrieves all CNAME records for a zone
-func (c *Client) ListCNAMERecords(zone string) ([]DNSRecord, error) {
-	return c.ListCNAMERecordsWithContext(context.Background(), zone)
-}
-
-func (c *Client) ListCNAMERecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/cname", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return records, nil
+// recordNameMatches reports whether a record name returned by the API refers to
+// the same host as name, see canonicalRecordName
+func recordNameMatches(zone, recordName, name string) bool {
+	return recordNamesEqual(zone, recordName, name)
 }
 
-// FindCNAMERecordByName finds a CNAME record by name in a zone
-func (c *Client) FindCNAMERecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindCNAMERecordByNameWithContext(context.Background(), zone, name)
-}
+// ==================== DNS Zone ====================
 
-func (c *Client) FindCNAMERecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListCNAMERecordsWithContext(ctx, zone)
+// GetDNSZone retrieves zone information
+func (c *Client) GetDNSZone(zone string) (*DNSZone, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/dns/%s", zone), nil)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
-}
-
-// FindAllCNAMERecordsByName finds ALL CNAME records with matching name in a zone
-func (c *Client) FindAllCNAMERecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllCNAMERecordsByNameWithContext(context.Background(), zone, name)
+	return parseDNSZoneResponse(resp)
 }
 
-func (c *Client) FindAllCNAMERecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListCNAMERecordsWithContext(ctx, zone)
+// UpdateDNSZone updates a zone's settings (activation and IPv6 auto-records)
+func (c *Client) UpdateDNSZone(zone string, update *DNSZoneUpdate) (*DNSZone, error) {
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/dns/%s", zone), update)
 	if err != nil {
 		return nil, err
 	}
+	return parseDNSZoneResponse(resp)
+}
 
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
+// ==================== Domain Management ====================
 
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
-	}
-	return matches, nil
+// Domain represents a domain in Zone.EU
+type Domain struct {
+	ResourceURL          string `json:"resource_url,omitempty"`
+	Name                 string `json:"name"`
+	Delegated            string `json:"delegated,omitempty"`
+	Expires              string `json:"expires,omitempty"`
+	DNSSEC               bool   `json:"dnssec"`
+	Autorenew            bool   `json:"autorenew"`
+	RenewOrder           string `json:"renew_order,omitempty"`
+	RenewalNotifications bool   `json:"renewal_notifications"`
+	HasPendingTrade      *int   `json:"has_pending_trade,omitempty"`
+	HasPendingDNSSEC     bool   `json:"has_pending_dnssec,omitempty"`
+	Reactivate           bool   `json:"reactivate,omitempty"`
+	AuthKeyEnabled       bool   `json:"auth_key_enabled,omitempty"`
+	SigningRequired      bool   `json:"signing_required,omitempty"`
+	NameserversCustom    bool   `json:"nameservers_custom"`
 }
 
-func (c *Client) GetCNAMERecord(zone, id string) (*DNSRecord, error) {
-	return c.GetCNAMERecordWithContext(context.Background(), zone, id)
+// DomainUpdate represents the updateable fields for a domain
+type DomainUpdate struct {
+	Autorenew         *bool `json:"autorenew,omitempty"`
+	DNSSEC            *bool `json:"dnssec,omitempty"`
+	NameserversCustom *bool `json:"nameservers_custom,omitempty"`
 }
 
-func (c *Client) GetCNAMERecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/cname/%s", zone, id), nil)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
+// DomainPreferences represents domain preferences
+type DomainPreferences struct {
+	ResourceURL          string `json:"resource_url,omitempty"`
+	RenewalNotifications bool   `json:"renewal_notifications"`
 }
 
-func (c *Client) CreateCNAMERecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateCNAMERecordWithContext(context.Background(), zone, record)
+// DomainNameserver represents a domain nameserver
+type DomainNameserver struct {
+	ResourceURL string   `json:"resource_url,omitempty"`
+	Hostname    string   `json:"hostname"`
+	IP          []string `json:"ip,omitempty"`
 }
 
-func (c *Client) CreateCNAMERecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/cname", zone), record)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
+// DomainFilter holds the server-side filters of GET /domain
+type DomainFilter struct {
+	// Name only returns domains whose name contains the string
+	Name string
+	// Delegated only returns domains delegated to the user (true) or owned
+	// by the user (false)
+	Delegated *bool
 }
 
-func (c *Client) UpdateCNAMERecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateCNAMERecordWithContext(context.Background(), zone, id, record)
-}
+// GetDomains retrieves all domains
+func (c *Client) GetDomains() ([]Domain, error) {
+	return c.GetDomainsWithFilter(nil)
+}
+
+// GetDomainsWithFilter retrieves all domains matching filter, following
+// pagination
+func (c *Client) GetDomainsWithFilter(filter *DomainFilter) ([]Domain, error) {
+	path := "/domain"
+	if filter != nil {
+		query := url.Values{}
+		if filter.Name != "" {
+			query.Set("name", filter.Name)
+		}
+		if filter.Delegated != nil {
+			query.Set("delegated", strconv.FormatBool(*filter.Delegated))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
 
-func (c *Client) UpdateCNAMERecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/cname/%s", zone, id), record)
+	resp, err := c.doListRequest(context.Background(), path, &ListOptions{OrderBy: "name", OrderDir: "asc"})
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) DeleteCNAMERecord(zone, id string) error {
-	return c.DeleteCNAMERecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) DeleteCNAMERecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/cname/%s", zone, id), nil)
-	return err
-}
-
-// ==================== MX Records ====================
-
-// ListMXRecords retrieves all MX records for a zone
-func (c *Client) ListMXRecords(zone string) ([]DNSRecord, error) {
-	return c.ListMXRecordsWithContext(context.Background(), zone)
+	var domains []Domain
+	if err := json.Unmarshal(resp, &domains); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return domains, nil
 }
 
-func (c *Client) ListMXRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/mx", zone), nil)
+// GetDomain retrieves a specific domain
+func (c *Client) GetDomain(name string) (*Domain, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s", name), nil)
 	if err != nil {
 		return nil, err
 	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
+	// API returns array with single element
+	var domains []Domain
+	if err := json.Unmarshal(resp, &domains); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return records, nil
-}
-
-// FindMXRecordByName finds an MX record by name in a zone
-func (c *Client) FindMXRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindMXRecordByNameWithContext(context.Background(), zone, name)
+	if len(domains) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("domain not found: %s", name)}
+	}
+	return &domains[0], nil
 }
 
-func (c *Client) FindMXRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListMXRecordsWithContext(ctx, zone)
+// UpdateDomain updates a domain's settings
+func (c *Client) UpdateDomain(name string, update *DomainUpdate) (*Domain, error) {
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s", name), update)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
+	var domains []Domain
+	if err := json.Unmarshal(resp, &domains); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return nil, nil // Not found
-}
-
-// FindAllMXRecordsByName finds ALL MX records with matching name in a zone
-func (c *Client) FindAllMXRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllMXRecordsByNameWithContext(context.Background(), zone, name)
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("domain not found: %s", name)
+	}
+	return &domains[0], nil
 }
 
-func (c *Client) FindAllMXRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListMXRecordsWithContext(ctx, zone)
+// GetDomainPreferences retrieves domain preferences
+func (c *Client) GetDomainPreferences(name string) (*DomainPreferences, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/preferences", name), nil)
 	if err != nil {
 		return nil, err
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+	var prefs []DomainPreferences
+	if err := json.Unmarshal(resp, &prefs); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return matches, nil
-}
-
-func (c *Client) GetMXRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetMXRecordWithContext(context.Background(), zone, id)
+	if len(prefs) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("domain preferences not found: %s", name)}
+	}
+	return &prefs[0], nil
 }
 
-func (c *Client) GetMXRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/mx/%s", zone, id), nil)
+// UpdateDomainPreferences updates domain preferences
+func (c *Client) UpdateDomainPreferences(name string, prefs *DomainPreferences) (*DomainPreferences, error) {
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s/preferences", name), prefs)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) CreateMXRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateMXRecordWithContext(context.Background(), zone, record)
+	var updated []DomainPreferences
+	if err := json.Unmarshal(resp, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(updated) == 0 {
+		return nil, fmt.Errorf("domain preferences not found: %s", name)
+	}
+	return &updated[0], nil
 }
 
-func (c *Client) CreateMXRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/mx", zone), record)
+// GetDomainNameservers retrieves all nameservers for a domain
+func (c *Client) GetDomainNameservers(domain string) ([]DomainNameserver, error) {
+	resp, err := c.doListRequest(context.Background(), fmt.Sprintf("/domain/%s/nameserver", domain), nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	var nameservers []DomainNameserver
+	if err := json.Unmarshal(resp, &nameservers); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return nameservers, nil
 }
 
-func (c *Client) UpdateMXRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateMXRecordWithContext(context.Background(), zone, id, record)
+// GetDomainNameserver retrieves a specific nameserver
+func (c *Client) GetDomainNameserver(domain, hostname string) (*DomainNameserver, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), nil)
+	if err != nil {
+		return nil, err
+	}
+	var nameservers []DomainNameserver
+	if err := json.Unmarshal(resp, &nameservers); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(nameservers) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("nameserver not found: %s", hostname)}
+	}
+	return &nameservers[0], nil
 }
 
-func (c *Client) UpdateMXRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/mx/%s", zone, id), record)
+// CreateDomainNameservers creates nameservers for a domain (replaces all)
+func (c *Client) CreateDomainNameservers(domain string, nameservers []DomainNameserver) ([]DomainNameserver, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/domain/%s/nameserver", domain), nameservers)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	var created []DomainNameserver
+	if err := json.Unmarshal(resp, &created); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return created, nil
 }
 
-func (c *Client) DeleteMXRecord(zone, id string) error {
-	return c.DeleteMXRecordWithContext(context.Background(), zone, id)
+// UpdateDomainNameserver updates a specific nameserver
+func (c *Client) UpdateDomainNameserver(domain, hostname string, ns *DomainNameserver) (*DomainNameserver, error) {
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), ns)
+	if err != nil {
+		return nil, err
+	}
+	var updated []DomainNameserver
+	if err := json.Unmarshal(resp, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(updated) == 0 {
+		return nil, fmt.Errorf("nameserver not found after update: %s", hostname)
+	}
+	return &updated[0], nil
 }
 
-func (c *Client) DeleteMXRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/mx/%s", zone, id), nil)
+// DeleteDomainNameserver deletes a nameserver
+func (c *Client) DeleteDomainNameserver(domain, hostname string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), nil)
 	return err
 }
 
-// ==================== TXT Records ====================
-
-// ListTXTRecords retrieves all TXT records for a zone
-func (c *Client) ListTXTRecords(zone string) ([]DNSRecord, error) {
-	return c.ListTXTRecordsWithContext(context.Background(), zone)
+// ==================== Domain Contacts ====================
+
+// DomainContact represents a registrant, admin or tech contact of a domain
+type DomainContact struct {
+	ResourceURL    string `json:"resource_url,omitempty"`
+	Identificator  int    `json:"identificator,omitempty"`
+	Role           string `json:"role"`
+	Type           string `json:"type"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	Name           string `json:"name"`
+	Organization   string `json:"organization"`
+	Email          string `json:"email"`
+	Voice          string `json:"voice"`
+	Fax            string `json:"fax"`
+	Country        string `json:"country"`
+	State          string `json:"state"`
+	City           string `json:"city"`
+	Street         string `json:"street"`
+	PostalCode     string `json:"postalcode"`
+	ExtLanguage    string `json:"ext_language"`
+	ExtIdent       string `json:"ext_ident"`
+	ExtIdentType   string `json:"ext_ident_type,omitempty"`
+	ExtIdentCC     string `json:"ext_ident_cc"`
+	ExtVATNr       string `json:"ext_vatnr"`
+	ExtDepartment  string `json:"ext_department"`
+	ExtPassport    string `json:"ext_passport"`
+	ExtLegalForm   string `json:"ext_legal_form"`
+	RegistryHandle string `json:"registry_handle"`
+}
+
+// parseDomainContactResponse parses an API response containing a single contact
+func parseDomainContactResponse(resp []byte, notFound string) (*DomainContact, error) {
+	var contacts []DomainContact
+	if err := json.Unmarshal(resp, &contacts); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(contacts) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
+	}
+	return &contacts[0], nil
 }
 
-func (c *Client) ListTXTRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/txt", zone), nil)
+// GetDomainContacts retrieves all contacts of a domain
+func (c *Client) GetDomainContacts(domain string) ([]DomainContact, error) {
+	resp, err := c.doListRequest(context.Background(), fmt.Sprintf("/domain/%s/contact", domain), nil)
 	if err != nil {
 		return nil, err
 	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
+	var contacts []DomainContact
+	if err := json.Unmarshal(resp, &contacts); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return records, nil
-}
-
-// FindTXTRecordByName finds a TXT record by name in a zone
-func (c *Client) FindTXTRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindTXTRecordByNameWithContext(context.Background(), zone, name)
+	return contacts, nil
 }
 
-func (c *Client) FindTXTRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListTXTRecordsWithContext(ctx, zone)
+// GetDomainContact retrieves a specific contact of a domain
+func (c *Client) GetDomainContact(domain, id string) (*DomainContact, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/contact/%s", domain, id), nil)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
-}
-
-// FindAllTXTRecordsByName finds ALL TXT records with matching name in a zone
-func (c *Client) FindAllTXTRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllTXTRecordsByNameWithContext(context.Background(), zone, name)
+	return parseDomainContactResponse(resp, fmt.Sprintf("contact not found: %s", id))
 }
 
-func (c *Client) FindAllTXTRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListTXTRecordsWithContext(ctx, zone)
+// CreateDomainContact creates a contact for a domain
+func (c *Client) CreateDomainContact(domain string, contact *DomainContact) (*DomainContact, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/domain/%s/contact", domain), contact)
 	if err != nil {
 		return nil, err
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
-	}
-	return matches, nil
-}
-
-func (c *Client) GetTXTRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetTXTRecordWithContext(context.Background(), zone, id)
+	return parseDomainContactResponse(resp, fmt.Sprintf("contact not returned after create for domain: %s", domain))
 }
 
-func (c *Client) GetTXTRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/txt/%s", zone, id), nil)
+// CreateDefaultTechContact adds the account's default tech contact to a domain
+func (c *Client) CreateDefaultTechContact(domain string) (*DomainContact, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/domain/%s/contact/tech/default", domain), nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) CreateTXTRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateTXTRecordWithContext(context.Background(), zone, record)
+	return parseDomainContactResponse(resp, fmt.Sprintf("contact not returned after create for domain: %s", domain))
 }
 
-func (c *Client) CreateTXTRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/txt", zone), record)
+// UpdateDomainContact updates a contact of a domain
+func (c *Client) UpdateDomainContact(domain, id string, contact *DomainContact) (*DomainContact, error) {
+	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s/contact/%s", domain, id), contact)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseDomainContactResponse(resp, fmt.Sprintf("contact not found after update: %s", id))
 }
 
-func (c *Client) UpdateTXTRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateTXTRecordWithContext(context.Background(), zone, id, record)
+// DeleteDomainContact deletes a contact of a domain
+func (c *Client) DeleteDomainContact(domain, id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/domain/%s/contact/%s", domain, id), nil)
+	return err
 }
 
-func (c *Client) UpdateTXTRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/txt/%s", zone, id), record)
-	if err != nil {
-		return nil, err
+// ==================== Domain Orders ====================
+
+// Order represents an order placed through the API
+type Order struct {
+	ResourceURL     string          `json:"resource_url,omitempty"`
+	Identificator   string          `json:"identificator"`
+	Errors          json.RawMessage `json:"errors,omitempty"`
+	DatetimeOrdered string          `json:"datetime_ordered,omitempty"`
+	VATRate         string          `json:"vat_rate,omitempty"`
+}
+
+// FailedRows returns the number of failed order rows as reported by the API,
+// or an empty string if none failed
+func (o *Order) FailedRows() string {
+	failed := strings.Trim(strings.TrimSpace(string(o.Errors)), `"`)
+	if failed == "null" || failed == "0" {
+		return ""
 	}
-	return parseDNSRecordResponse(resp)
+	return failed
 }
 
-func (c *Client) DeleteTXTRecord(zone, id string) error {
-	return c.DeleteTXTRecordWithContext(context.Background(), zone, id)
+// DomainOptions represents the options of a domain returned by OPTIONS
+// /domain/{name}
+type DomainOptions struct {
+	RenewalOptions []DomainRenewalOption `json:"renewal_options"`
 }
 
-func (c *Client) DeleteTXTRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/txt/%s", zone, id), nil)
-	return err
+// DomainRenewalOption is a renewal action available for a domain: renew,
+// reactivate_redeem or reactivate_grace
+type DomainRenewalOption struct {
+	Action        string                `json:"action"`
+	ActionPeriods []DomainRenewalPeriod `json:"action_periods"`
 }
 
-// ==================== NS Records ====================
+// DomainRenewalPeriod is a period a domain can be renewed for, with prices
+type DomainRenewalPeriod struct {
+	Period            int     `json:"period"`
+	ExpireNew         string  `json:"expire_new,omitempty"`
+	PricePerYear      float64 `json:"price_per_year"`
+	TotalPrice        float64 `json:"total_price"`
+	TotalPriceWithTax float64 `json:"total_price_with_tax"`
+}
 
-// ListNSRecords retrieves all NS records for a zone
-func (c *Client) ListNSRecords(zone string) ([]DNSRecord, error) {
-	return c.ListNSRecordsWithContext(context.Background(), zone)
+// domainRenewRequest is a row of a POST /order/domain/renew request
+type domainRenewRequest struct {
+	Domain string `json:"domain"`
+	Period int    `json:"period"`
 }
 
-func (c *Client) ListNSRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/ns", zone), nil)
+// GetDomainOptions retrieves the renewal options of a domain
+func (c *Client) GetDomainOptions(name string) (*DomainOptions, error) {
+	resp, err := c.doRequest("OPTIONS", fmt.Sprintf("/domain/%s", name), nil)
 	if err != nil {
 		return nil, err
 	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
+	var options DomainOptions
+	if err := json.Unmarshal(resp, &options); err != nil {
+		// Tolerate the array wrapping used by most other endpoints
+		var wrapped []DomainOptions
+		if err := json.Unmarshal(resp, &wrapped); err != nil || len(wrapped) == 0 {
+			return nil, fmt.Errorf("error parsing response: %w", err)
+		}
+		options = wrapped[0]
 	}
-	return records, nil
-}
-
-// FindNSRecordByName finds an NS record by name in a zone
-func (c *Client) FindNSRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindNSRecordByNameWithContext(context.Background(), zone, name)
+	return &options, nil
 }
 
-func (c *Client) FindNSRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListNSRecordsWithContext(ctx, zone)
+// RenewDomain places an order to renew or reactivate a domain for period,
+// which must be one of the periods from GetDomainOptions
+func (c *Client) RenewDomain(name string, period int) (*Order, error) {
+	resp, err := c.doRequest("POST", "/order/domain/renew", []domainRenewRequest{{Domain: name, Period: period}})
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
+	var orders []Order
+	if err := json.Unmarshal(resp, &orders); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return nil, nil // Not found
-}
-
-// FindAllNSRecordsByName finds ALL NS records with matching name in a zone
-func (c *Client) FindAllNSRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllNSRecordsByNameWithContext(context.Background(), zone, name)
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("no order returned for renewal of domain: %s", name)
+	}
+	return &orders[0], nil
 }
 
-func (c *Client) FindAllNSRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListNSRecordsWithContext(ctx, zone)
+// GetDomainOrder retrieves a domain order
+func (c *Client) GetDomainOrder(id string) (*Order, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/order/domain/%s", id), nil)
 	if err != nil {
 		return nil, err
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+	var orders []Order
+	if err := json.Unmarshal(resp, &orders); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return matches, nil
+	if len(orders) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("order not found: %s", id)}
+	}
+	return &orders[0], nil
 }
 
-func (c *Client) GetNSRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetNSRecordWithContext(context.Background(), zone, id)
+// ==================== Webhosting Mail ====================
+
+// MailAccount represents an e-mail account of a webhosting service. Read-only
+// fields are omitted from requests when empty.
+type MailAccount struct {
+	ResourceURL      string   `json:"resource_url,omitempty"`
+	Address          string   `json:"address"`
+	Password         string   `json:"password,omitempty"`
+	Comment          string   `json:"comment"`
+	DiskSize         int64    `json:"disk_size,omitempty"`
+	DiskSizeHuman    string   `json:"disk_size_human,omitempty"`
+	DiskUsage        int64    `json:"disk_usage,omitempty"`
+	DiskUsageHuman   string   `json:"disk_usage_human,omitempty"`
+	DiskUsageUpdated string   `json:"disk_usage_updated,omitempty"`
+	FwdAddresses     []string `json:"fwd_addresses"`
+	Autoreply        bool     `json:"autoreply,omitempty"`
+	Spamlevel        string   `json:"spamlevel,omitempty"`
+	TwoFactorAuth    *bool    `json:"two_factor_auth,omitempty"`
+	DeletedAt        string   `json:"deleted_at,omitempty"`
+	Addresses        []string `json:"addresses,omitempty"`
 }
 
-func (c *Client) GetNSRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/ns/%s", zone, id), nil)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
+// Kinds of mailboxes, used in the API paths of mail accounts and forwarders
+const (
+	MailAccountKind   = "account"
+	MailForwarderKind = "forwarder"
+)
+
+// mailPath returns the API path of a mail account or forwarder
+func mailPath(service, kind, address string) string {
+	return fmt.Sprintf("/vserver/%s/mail/%s/%s", service, kind, url.PathEscape(address))
 }
 
-func (c *Client) CreateNSRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateNSRecordWithContext(context.Background(), zone, record)
+// parseMailAccountResponse returns the first account of an API response
+func parseMailAccountResponse(resp []byte, notFound string) (*MailAccount, error) {
+	var accounts []MailAccount
+	if err := json.Unmarshal(resp, &accounts); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
+	}
+	return &accounts[0], nil
 }
 
-func (c *Client) CreateNSRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/ns", zone), record)
+// GetMailAccounts retrieves all e-mail accounts of a webhosting service
+func (c *Client) GetMailAccounts(service string) ([]MailAccount, error) {
+	resp, err := c.doListRequest(context.Background(), fmt.Sprintf("/vserver/%s/mail/account", service), &ListOptions{OrderBy: "address", OrderDir: "asc"})
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	var accounts []MailAccount
+	if err := json.Unmarshal(resp, &accounts); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return accounts, nil
 }
 
-func (c *Client) UpdateNSRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateNSRecordWithContext(context.Background(), zone, id, record)
+// GetMailAccount retrieves an e-mail account, including its associated
+// addresses
+func (c *Client) GetMailAccount(service, address string) (*MailAccount, error) {
+	resp, err := c.doRequest("GET", mailPath(service, MailAccountKind, address)+"?addresses=true", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseMailAccountResponse(resp, fmt.Sprintf("mail account not found: %s", address))
 }
 
-func (c *Client) UpdateNSRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/ns/%s", zone, id), record)
+// CreateMailAccount creates an e-mail account
+func (c *Client) CreateMailAccount(service string, account *MailAccount) (*MailAccount, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/vserver/%s/mail/account", service), account)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseMailAccountResponse(resp, "no mail account returned after create")
 }
 
-func (c *Client) DeleteNSRecord(zone, id string) error {
-	return c.DeleteNSRecordWithContext(context.Background(), zone, id)
+// UpdateMailAccount updates an e-mail account. The password is only changed
+// if account.Password is set.
+func (c *Client) UpdateMailAccount(service, address string, account *MailAccount) (*MailAccount, error) {
+	resp, err := c.doRequest("PUT", mailPath(service, MailAccountKind, address), account)
+	if err != nil {
+		return nil, err
+	}
+	return parseMailAccountResponse(resp, fmt.Sprintf("mail account not found after update: %s", address))
 }
 
-func (c *Client) DeleteNSRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/ns/%s", zone, id), nil)
+// DeleteMailAccount deletes an e-mail account
+func (c *Client) DeleteMailAccount(service, address string) error {
+	_, err := c.doRequest("DELETE", mailPath(service, MailAccountKind, address), nil)
 	return err
 }
 
-// ==================== SRV Records ====================
+// MailForwarder represents an e-mail forwarder of a webhosting service
+type MailForwarder struct {
+	ResourceURL   string   `json:"resource_url,omitempty"`
+	Address       string   `json:"address"`
+	Comment       string   `json:"comment"`
+	FwdAddresses  []string `json:"fwd_addresses"`
+	Autoreply     bool     `json:"autoreply,omitempty"`
+	MailToHTTPURL string   `json:"mail_to_http_url,omitempty"`
+}
 
-// ListSRVRecords retrieves all SRV records for a zone
-func (c *Client) ListSRVRecords(zone string) ([]DNSRecord, error) {
-	return c.ListSRVRecordsWithContext(context.Background(), zone)
+// parseMailForwarderResponse returns the first forwarder of an API response
+func parseMailForwarderResponse(resp []byte, notFound string) (*MailForwarder, error) {
+	var forwarders []MailForwarder
+	if err := json.Unmarshal(resp, &forwarders); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(forwarders) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
+	}
+	return &forwarders[0], nil
 }
 
-func (c *Client) ListSRVRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/srv", zone), nil)
+// GetMailForwarders retrieves all e-mail forwarders of a webhosting service
+func (c *Client) GetMailForwarders(service string) ([]MailForwarder, error) {
+	resp, err := c.doListRequest(context.Background(), fmt.Sprintf("/vserver/%s/mail/forwarder", service), nil)
 	if err != nil {
 		return nil, err
 	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
+	var forwarders []MailForwarder
+	if err := json.Unmarshal(resp, &forwarders); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return records, nil
-}
-
-// FindSRVRecordByName finds an SRV record by name in a zone
-func (c *Client) FindSRVRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindSRVRecordByNameWithContext(context.Background(), zone, name)
+	return forwarders, nil
 }
 
-func (c *Client) FindSRVRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListSRVRecordsWithContext(ctx, zone)
+// GetMailForwarder retrieves an e-mail forwarder
+func (c *Client) GetMailForwarder(service, address string) (*MailForwarder, error) {
+	resp, err := c.doRequest("GET", mailPath(service, MailForwarderKind, address), nil)
 	if err != nil {
 		return nil, err
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
+	return parseMailForwarderResponse(resp, fmt.Sprintf("mail forwarder not found: %s", address))
 }
 
-// FindAllSRVRecordsByName finds ALL SRV records with matching name in a zone
-func (c *Client) FindAllSRVRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllSRVRecordsByNameWithContext(context.Background(), zone, name)
+// CreateMailForwarder creates an e-mail forwarder
+func (c *Client) CreateMailForwarder(service string, forwarder *MailForwarder) (*MailForwarder, error) {
+	resp, err := c.doRequest("POST", fmt.Sprintf("/vserver/%s/mail/forwarder", service), forwarder)
+	if err != nil {
+		return nil, err
+	}
+	return parseMailForwarderResponse(resp, "no mail forwarder returned after create")
 }
 
-func (c *Client) FindAllSRVRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListSRVRecordsWithContext(ctx, zone)
+// UpdateMailForwarder updates an e-mail forwarder
+func (c *Client) UpdateMailForwarder(service, address string, forwarder *MailForwarder) (*MailForwarder, error) {
+	resp, err := c.doRequest("PUT", mailPath(service, MailForwarderKind, address), forwarder)
 	if err != nil {
 		return nil, err
 	}
+	return parseMailForwarderResponse(resp, fmt.Sprintf("mail forwarder not found after update: %s", address))
+}
 
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
+// DeleteMailForwarder deletes an e-mail forwarder
+func (c *Client) DeleteMailForwarder(service, address string) error {
+	_, err := c.doRequest("DELETE", mailPath(service, MailForwarderKind, address), nil)
+	return err
+}
 
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
+// MailAutoreply represents the autoreply of a mail account or forwarder
+type MailAutoreply struct {
+	ResourceURL string `json:"resource_url,omitempty"`
+	IsEnabled   bool   `json:"is_enabled"`
+	Fromname    string `json:"fromname"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+	// Dates are in yyyy-mm-dd format, empty for no limit
+	Datestart string `json:"datestart"`
+	Dateend   string `json:"dateend"`
+}
+
+// parseMailAutoreplyResponse returns the autoreply of an API response, which
+// is either an object or an array with one element
+func parseMailAutoreplyResponse(resp []byte, notFound string) (*MailAutoreply, error) {
+	var autoreplies []MailAutoreply
+	if err := json.Unmarshal(resp, &autoreplies); err != nil {
+		var autoreply MailAutoreply
+		if err := json.Unmarshal(resp, &autoreply); err != nil {
+			return nil, fmt.Errorf("error parsing response: %w", err)
 		}
+		return &autoreply, nil
 	}
-	return matches, nil
+	if len(autoreplies) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
+	}
+	return &autoreplies[0], nil
 }
 
-func (c *Client) GetSRVRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetSRVRecordWithContext(context.Background(), zone, id)
+// GetMailAutoreply retrieves the autoreply of a mail account or forwarder.
+// kind is MailAccountKind or MailForwarderKind.
+func (c *Client) GetMailAutoreply(service, kind, address string) (*MailAutoreply, error) {
+	resp, err := c.doRequest("GET", mailPath(service, kind, address)+"/autoreply", nil)
+	if err != nil {
+		return nil, err
+	}
+	return parseMailAutoreplyResponse(resp, fmt.Sprintf("autoreply not found: %s", address))
 }
 
-func (c *Client) GetSRVRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/srv/%s", zone, id), nil)
+// UpdateMailAutoreply sets the autoreply of a mail account or forwarder
+func (c *Client) UpdateMailAutoreply(service, kind, address string, autoreply *MailAutoreply) (*MailAutoreply, error) {
+	resp, err := c.doRequest("PUT", mailPath(service, kind, address)+"/autoreply", autoreply)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseMailAutoreplyResponse(resp, fmt.Sprintf("autoreply not found after update: %s", address))
 }
 
-func (c *Client) CreateSRVRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateSRVRecordWithContext(context.Background(), zone, record)
+// MailDKIM represents the DKIM key of a webhosting service. Only identificator
+// and resource_url are part of the published schema; the selector and key
+// fields are read as returned by GET /vserver/{service}/mail/dkim.
+type MailDKIM struct {
+	Identificator string `json:"identificator,omitempty"`
+	ResourceURL   string `json:"resource_url,omitempty"`
+	Selector      string `json:"selector,omitempty"`
+	PublicKey     string `json:"public_key,omitempty"`
+	// Record is the complete TXT record value, if the API provides one
+	Record string `json:"record,omitempty"`
 }
 
-func (c *Client) CreateSRVRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/srv", zone), record)
-	if err != nil {
-		return nil, err
+// TXTValue returns the value of the <selector>._domainkey TXT record
+// publishing the key: the record returned by the API, or one built from the
+// public key with any PEM armor removed
+func (d *MailDKIM) TXTValue() string {
+	if strings.HasPrefix(d.Record, "v=DKIM1") {
+		return d.Record
 	}
-	return parseDNSRecordResponse(resp)
-}
 
-func (c *Client) UpdateSRVRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateSRVRecordWithContext(context.Background(), zone, id, record)
+	var key strings.Builder
+	for _, line := range strings.Split(d.PublicKey, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "-----") {
+			continue
+		}
+		key.WriteString(line)
+	}
+	return "v=DKIM1; k=rsa; p=" + key.String()
 }
 
-func (c *Client) UpdateSRVRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/srv/%s", zone, id), record)
+// GetMailDKIM retrieves the DKIM key of a webhosting service
+func (c *Client) GetMailDKIM(service string) (*MailDKIM, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/vserver/%s/mail/dkim", service), nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	var keys []MailDKIM
+	if err := json.Unmarshal(resp, &keys); err != nil {
+		// Tolerate a single object instead of the usual array
+		var key MailDKIM
+		if err := json.Unmarshal(resp, &key); err != nil {
+			return nil, fmt.Errorf("error parsing response: %w", err)
+		}
+		return &key, nil
+	}
+	if len(keys) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("DKIM key not found: %s", service)}
+	}
+	return &keys[0], nil
 }
 
-func (c *Client) DeleteSRVRecord(zone, id string) error {
-	return c.DeleteSRVRecordWithContext(context.Background(), zone, id)
+// EnableMailDKIM activates DKIM signing for a webhosting service, generating
+// a new key
+func (c *Client) EnableMailDKIM(service string) error {
+	_, err := c.doRequest("POST", fmt.Sprintf("/vserver/%s/mail/dkim", service), nil)
+	return err
 }
 
-func (c *Client) DeleteSRVRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/srv/%s", zone, id), nil)
+// DeleteMailDKIM deletes the DKIM key of a webhosting service
+func (c *Client) DeleteMailDKIM(service string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("/vserver/%s/mail/dkim", service), nil)
 	return err
 }
 
-// ==================== CAA Records ====================
-
-// ListCAARecords retrieves all CAA records for a zone
-func (c *Client) ListCAARecords(zone string) ([]DNSRecord, error) {
-	return c.ListCAARecordsWithContext(context.Background(), zone)
-}
-
-func (c *Client) ListCAARecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/caa", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return records, nil
-}
-
-// FindCAARecordByName finds a CAA record by name in a zone
-func (c *Client) FindCAARecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindCAARecordByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindCAARecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListCAARecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
+// ApplicationPassword represents an application specific password of a mail
+// account
+type ApplicationPassword struct {
+	// Identificator is a number, returned as either a JSON number or string
+	Identificator json.Number `json:"identificator"`
+	ResourceURL   string      `json:"resource_url,omitempty"`
+	Type          string      `json:"type"`
+	Name          string      `json:"name"`
+	Scopes        []string    `json:"scopes"`
+	Created       *string     `json:"created"`
+	// LastUsed is a date string, or false if the password has never been used
+	LastUsed json.RawMessage `json:"last_used"`
 }
 
-// FindAllCAARecordsByName finds ALL CAA records with matching name in a zone
-func (c *Client) FindAllCAARecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllCAARecordsByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindAllCAARecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListCAARecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+// LastUsedAt returns when the password was last used, or "" if it has never
+// been used
+func (p *ApplicationPassword) LastUsedAt() string {
+	var lastUsed string
+	if err := json.Unmarshal(p.LastUsed, &lastUsed); err != nil {
+		return ""
 	}
-	return matches, nil
+	return lastUsed
 }
 
-func (c *Client) GetCAARecord(zone, id string) (*DNSRecord, error) {
-	return c.GetCAARecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) GetCAARecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/caa/%s", zone, id), nil)
+// GetMailAccountAppPasswords retrieves the application specific passwords of
+// a mail account
+func (c *Client) GetMailAccountAppPasswords(service, address string) ([]ApplicationPassword, error) {
+	resp, err := c.doRequest("GET", mailPath(service, MailAccountKind, address)+"/asp", nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) CreateCAARecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateCAARecordWithContext(context.Background(), zone, record)
-}
-
-func (c *Client) CreateCAARecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/caa", zone), record)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) UpdateCAARecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateCAARecordWithContext(context.Background(), zone, id, record)
-}
-
-func (c *Client) UpdateCAARecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/caa/%s", zone, id), record)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) DeleteCAARecord(zone, id string) error {
-	return c.DeleteCAARecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) DeleteCAARecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/caa/%s", zone, id), nil)
-	return err
-}
-
-// ==================== TLSA Records ====================
-
-// ListTLSARecords retrieves all TLSA records for a zone
-func (c *Client) ListTLSARecords(zone string) ([]DNSRecord, error) {
-	return c.ListTLSARecordsWithContext(context.Background(), zone)
-}
-
-func (c *Client) ListTLSARecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/tlsa", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
+	var passwords []ApplicationPassword
+	if err := json.Unmarshal(resp, &passwords); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return records, nil
+	return passwords, nil
 }
 
-// FindTLSARecordByName finds a TLSA record by name in a zone
-func (c *Client) FindTLSARecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindTLSARecordByNameWithContext(context.Background(), zone, name)
+// DeleteMailAccountAppPassword revokes an application specific password of a
+// mail account
+func (c *Client) DeleteMailAccountAppPassword(service, address, id string) error {
+	_, err := c.doRequest("DELETE", fmt.Sprintf("%s/asp/%s", mailPath(service, MailAccountKind, address), id), nil)
+	return err
 }
 
-func (c *Client) FindTLSARecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListTLSARecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
+// MailAccountPremium represents the premium package of a mail account, or a
+// package available for it
+type MailAccountPremium struct {
+	// Package is the package handle, "none" if the account is not premium
+	Package        string  `json:"package"`
+	PackageAllowed bool    `json:"package_allowed"`
+	DiskSize       int64   `json:"disk_size"`
+	DiskSizeHuman  string  `json:"disk_size_human"`
+	PriceMonth     float64 `json:"price_month"`
+	PriceYear      float64 `json:"price_year"`
 }
 
-// FindAllTLSARecordsByName finds ALL TLSA records with matching name in a zone
-func (c *Client) FindAllTLSARecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllTLSARecordsByNameWithContext(context.Background(), zone, name)
-}
+// MailPremiumNone is the package handle of a mail account without a premium
+// package
+const MailPremiumNone = "none"
 
-func (c *Client) FindAllTLSARecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListTLSARecordsWithContext(ctx, zone)
+// GetMailAccountPremium retrieves the premium package of a mail account
+func (c *Client) GetMailAccountPremium(service, address string) (*MailAccountPremium, error) {
+	resp, err := c.doRequest("GET", mailPath(service, MailAccountKind, address)+"/premium", nil)
 	if err != nil {
 		return nil, err
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+	var packages []MailAccountPremium
+	if err := json.Unmarshal(resp, &packages); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return matches, nil
-}
-
-func (c *Client) GetTLSARecord(zone, id string) (*DNSRecord, error) {
-	return c.GetTLSARecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) GetTLSARecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/tlsa/%s", zone, id), nil)
-	if err != nil {
-		return nil, err
+	if len(packages) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: fmt.Sprintf("premium status not found: %s", address)}
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) CreateTLSARecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateTLSARecordWithContext(context.Background(), zone, record)
+	return &packages[0], nil
 }
 
-func (c *Client) CreateTLSARecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/tlsa", zone), record)
+// GetMailAccountPremiumOptions retrieves the premium packages available for a
+// mail account. Use "@<service>" as address for a new account.
+func (c *Client) GetMailAccountPremiumOptions(service, address string) ([]MailAccountPremium, error) {
+	resp, err := c.doRequest("OPTIONS", mailPath(service, MailAccountKind, address)+"/premium", nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) UpdateTLSARecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateTLSARecordWithContext(context.Background(), zone, id, record)
-}
-
-func (c *Client) UpdateTLSARecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/tlsa/%s", zone, id), record)
-	if err != nil {
-		return nil, err
+	var packages []MailAccountPremium
+	if err := json.Unmarshal(resp, &packages); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return parseDNSRecordResponse(resp)
+	return packages, nil
 }
 
-func (c *Client) DeleteTLSARecord(zone, id string) error {
-	return c.DeleteTLSARecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) DeleteTLSARecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/tlsa/%s", zone, id), nil)
+// SetMailAccountPremium activates a premium package for a mail account, or
+// deactivates it with MailPremiumNone
+func (c *Client) SetMailAccountPremium(service, address, pkg string) error {
+	body := map[string]string{"package": pkg}
+	_, err := c.doRequest("PUT", mailPath(service, MailAccountKind, address)+"/premium", body)
 	return err
 }
 
-// ==================== SSHFP Records ====================
-
-// ListSSHFPRecords retrieves all SSHFP records for a zone
-func (c *Client) ListSSHFPRecords(zone string) ([]DNSRecord, error) {
-	return c.ListSSHFPRecordsWithContext(context.Background(), zone)
-}
-
-func (c *Client) ListSSHFPRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/sshfp", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return records, nil
-}
+// ==================== Webhosting MySQL ====================
 
-// FindSSHFPRecordByName finds an SSHFP record by name in a zone
-func (c *Client) FindSSHFPRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindSSHFPRecordByNameWithContext(context.Background(), zone, name)
+// MySQLDatabase represents a MySQL database of a webhosting service. Zone.EU
+// prefixes the name given on create with the service's database prefix.
+type MySQLDatabase struct {
+	ResourceURL string `json:"resource_url,omitempty"`
+	Name        string `json:"name"`
+	Comment     string `json:"comment"`
+	// Collation can only be set on create
+	Collation        string `json:"collation,omitempty"`
+	DiskUsageHuman   string `json:"disk_usage_human,omitempty"`
+	DiskUsageUpdated string `json:"disk_usage_updated,omitempty"`
+}
+
+// MySQLAccount represents a MySQL account of a webhosting service. Zone.EU
+// prefixes the username given on create with the service's database prefix.
+type MySQLAccount struct {
+	ResourceURL string `json:"resource_url,omitempty"`
+	Username    string `json:"username"`
+	Comment     string `json:"comment"`
+	Password    string `json:"password,omitempty"`
+	// RequireSSL can only be set on create
+	RequireSSL *bool `json:"require_ssl,omitempty"`
+	// Hosts are IP addresses, or "ws" (webserver), "pma" (phpMyAdmin) and
+	// "vpn", that may connect with the account
+	Hosts []string `json:"hosts"`
+}
+
+// MySQLPermission represents the permissions of a MySQL account on one
+// database
+type MySQLPermission struct {
+	ResourceURL string   `json:"resource_url,omitempty"`
+	Username    string   `json:"username,omitempty"`
+	Database    string   `json:"database,omitempty"`
+	Permissions []string `json:"permissions"`
 }
 
-func (c *Client) FindSSHFPRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListSSHFPRecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
+// mysqlPath returns the API path of the MySQL databases of a service, or of
+// the entity under it given by elems
+func mysqlPath(service string, elems ...string) string {
+	p := fmt.Sprintf("/vserver/%s/database/mysql", service)
+	for _, elem := range elems {
+		p += "/" + url.PathEscape(elem)
 	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
+	return p
 }
 
-// FindAllSSHFPRecordsByName finds ALL SSHFP records with matching name in a zone
-func (c *Client) FindAllSSHFPRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllSSHFPRecordsByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindAllSSHFPRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListSSHFPRecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+// parseMySQLDatabaseResponse returns the first database of an API response
+func parseMySQLDatabaseResponse(resp []byte, notFound string) (*MySQLDatabase, error) {
+	var databases []MySQLDatabase
+	if err := json.Unmarshal(resp, &databases); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return matches, nil
-}
-
-func (c *Client) GetSSHFPRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetSSHFPRecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) GetSSHFPRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/sshfp/%s", zone, id), nil)
-	if err != nil {
-		return nil, err
+	if len(databases) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
 	}
-	return parseDNSRecordResponse(resp)
+	return &databases[0], nil
 }
 
-func (c *Client) CreateSSHFPRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateSSHFPRecordWithContext(context.Background(), zone, record)
-}
-
-func (c *Client) CreateSSHFPRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/sshfp", zone), record)
+// GetMySQLDatabase retrieves a MySQL database by its full name
+func (c *Client) GetMySQLDatabase(service, name string) (*MySQLDatabase, error) {
+	resp, err := c.doRequest("GET", mysqlPath(service, name), nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseMySQLDatabaseResponse(resp, fmt.Sprintf("MySQL database not found: %s", name))
 }
 
-func (c *Client) UpdateSSHFPRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateSSHFPRecordWithContext(context.Background(), zone, id, record)
-}
-
-func (c *Client) UpdateSSHFPRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/sshfp/%s", zone, id), record)
+// CreateMySQLDatabase creates a MySQL database
+func (c *Client) CreateMySQLDatabase(service string, database *MySQLDatabase) (*MySQLDatabase, error) {
+	resp, err := c.doRequest("POST", mysqlPath(service), database)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseMySQLDatabaseResponse(resp, "no MySQL database returned after create")
 }
 
-func (c *Client) DeleteSSHFPRecord(zone, id string) error {
-	return c.DeleteSSHFPRecordWithContext(context.Background(), zone, id)
-}
-
-func (c *Client) DeleteSSHFPRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/sshfp/%s", zone, id), nil)
+// DeleteMySQLDatabase deletes a MySQL database
+func (c *Client) DeleteMySQLDatabase(service, name string) error {
+	_, err := c.doRequest("DELETE", mysqlPath(service, name), nil)
 	return err
 }
 
-// ==================== URL Records ====================
-
-// ListURLRecords retrieves all URL records for a zone
-func (c *Client) ListURLRecords(zone string) ([]DNSRecord, error) {
-	return c.ListURLRecordsWithContext(context.Background(), zone)
-}
-
-func (c *Client) ListURLRecordsWithContext(ctx context.Context, zone string) ([]DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/url", zone), nil)
+// GetMySQLCollations retrieves the collations available for new databases
+func (c *Client) GetMySQLCollations(service string) ([]string, error) {
+	resp, err := c.doRequest("OPTIONS", mysqlPath(service), nil)
 	if err != nil {
 		return nil, err
 	}
-	var records []DNSRecord
-	if err := json.Unmarshal(resp, &records); err != nil {
+	var collations []string
+	if err := json.Unmarshal(resp, &collations); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	return records, nil
-}
-
-// FindURLRecordByName finds a URL record by name in a zone
-func (c *Client) FindURLRecordByName(zone, name string) (*DNSRecord, error) {
-	return c.FindURLRecordByNameWithContext(context.Background(), zone, name)
-}
-
-func (c *Client) FindURLRecordByNameWithContext(ctx context.Context, zone, name string) (*DNSRecord, error) {
-	records, err := c.ListURLRecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
-	}
-	
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-	
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			return &r, nil
-		}
-	}
-	return nil, nil // Not found
-}
-
-// FindAllURLRecordsByName finds ALL URL records with matching name in a zone
-func (c *Client) FindAllURLRecordsByName(zone, name string) ([]DNSRecord, error) {
-	return c.FindAllURLRecordsByNameWithContext(context.Background(), zone, name)
+	return collations, nil
 }
 
-func (c *Client) FindAllURLRecordsByNameWithContext(ctx context.Context, zone, name string) ([]DNSRecord, error) {
-	records, err := c.ListURLRecordsWithContext(ctx, zone)
-	if err != nil {
-		return nil, err
+// parseMySQLAccountResponse returns the first account of an API response
+func parseMySQLAccountResponse(resp []byte, notFound string) (*MySQLAccount, error) {
+	var accounts []MySQLAccount
+	if err := json.Unmarshal(resp, &accounts); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-
-	// Normalize the search name - strip zone suffix if present
-	zoneSuffix := "." + zone
-	searchName := strings.TrimSuffix(name, zoneSuffix)
-
-	var matches []DNSRecord
-	for _, r := range records {
-		// Normalize the record name as well
-		recordName := strings.TrimSuffix(r.Name, zoneSuffix)
-		if recordName == searchName || r.Name == name {
-			matches = append(matches, r)
-		}
+	if len(accounts) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
 	}
-	return matches, nil
-}
-
-func (c *Client) GetURLRecord(zone, id string) (*DNSRecord, error) {
-	return c.GetURLRecordWithContext(context.Background(), zone, id)
+	return &accounts[0], nil
 }
 
-func (c *Client) GetURLRecordWithContext(ctx context.Context, zone, id string) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "GET", fmt.Sprintf("/dns/%s/url/%s", zone, id), nil)
+// GetMySQLAccount retrieves a MySQL account by its full username
+func (c *Client) GetMySQLAccount(service, username string) (*MySQLAccount, error) {
+	resp, err := c.doRequest("GET", mysqlPath(service, "account", username), nil)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) CreateURLRecord(zone string, record *DNSRecord) (*DNSRecord, error) {
-	return c.CreateURLRecordWithContext(context.Background(), zone, record)
+	return parseMySQLAccountResponse(resp, fmt.Sprintf("MySQL account not found: %s", username))
 }
 
-func (c *Client) CreateURLRecordWithContext(ctx context.Context, zone string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "POST", fmt.Sprintf("/dns/%s/url", zone), record)
+// CreateMySQLAccount creates a MySQL account
+func (c *Client) CreateMySQLAccount(service string, account *MySQLAccount) (*MySQLAccount, error) {
+	resp, err := c.doRequest("POST", mysqlPath(service, "account"), account)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
+	return parseMySQLAccountResponse(resp, "no MySQL account returned after create")
 }
 
-func (c *Client) UpdateURLRecord(zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	return c.UpdateURLRecordWithContext(context.Background(), zone, id, record)
-}
-
-func (c *Client) UpdateURLRecordWithContext(ctx context.Context, zone, id string, record *DNSRecord) (*DNSRecord, error) {
-	resp, err := c.doRequestWithContext(ctx, "PUT", fmt.Sprintf("/dns/%s/url/%s", zone, id), record)
+// UpdateMySQLAccount updates the comment, hosts and, if account.Password is
+// set, the password of a MySQL account
+func (c *Client) UpdateMySQLAccount(service, username string, account *MySQLAccount) (*MySQLAccount, error) {
+	resp, err := c.doRequest("PUT", mysqlPath(service, "account", username), account)
 	if err != nil {
 		return nil, err
 	}
-	return parseDNSRecordResponse(resp)
-}
-
-func (c *Client) DeleteURLRecord(zone, id string) error {
-	return c.DeleteURLRecordWithContext(context.Background(), zone, id)
+	return parseMySQLAccountResponse(resp, fmt.Sprintf("MySQL account not found after update: %s", username))
 }
 
-func (c *Client) DeleteURLRecordWithContext(ctx context.Context, zone, id string) error {
-	_, err := c.doRequestWithContext(ctx, "DELETE", fmt.Sprintf("/dns/%s/url/%s", zone, id), nil)
+// DeleteMySQLAccount deletes a MySQL account
+func (c *Client) DeleteMySQLAccount(service, username string) error {
+	_, err := c.doRequest("DELETE", mysqlPath(service, "account", username), nil)
 	return err
 }
 
-// ==================== DNS Zone ====================
-
-func (c *Client) GetDNSZone(zone string) (*DNSZone, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/dns/%s", zone), nil)
-	if err != nil {
-		return nil, err
-	}
-	return parseDNSZoneResponse(resp)
-}
-
-// ==================== Domain Management ====================
-
-// Domain represents a domain in Zone.EU
-type Domain struct {
-	ResourceURL          string `json:"resource_url,omitempty"`
-	Name                 string `json:"name"`
-	Delegated            string `json:"delegated,omitempty"`
-	Expires              string `json:"expires,omitempty"`
-	DNSSEC               bool   `json:"dnssec"`
-	Autorenew            bool   `json:"autorenew"`
-	RenewOrder           string `json:"renew_order,omitempty"`
-	RenewalNotifications bool   `json:"renewal_notifications"`
-	HasPendingTrade      *int   `json:"has_pending_trade,omitempty"`
-	HasPendingDNSSEC     bool   `json:"has_pending_dnssec,omitempty"`
-	Reactivate           bool   `json:"reactivate,omitempty"`
-	AuthKeyEnabled       bool   `json:"auth_key_enabled,omitempty"`
-	SigningRequired      bool   `json:"signing_required,omitempty"`
-	NameserversCustom    bool   `json:"nameservers_custom"`
-}
-
-// DomainUpdate represents the updateable fields for a domain
-type DomainUpdate struct {
-	Autorenew         *bool `json:"autorenew,omitempty"`
-	DNSSEC            *bool `json:"dnssec,omitempty"`
-	NameserversCustom *bool `json:"nameservers_custom,omitempty"`
-}
-
-// DomainPreferences represents domain preferences
-type DomainPreferences struct {
-	ResourceURL          string `json:"resource_url,omitempty"`
-	RenewalNotifications bool   `json:"renewal_notifications"`
-}
-
-// DomainNameserver represents a domain nameserver
-type DomainNameserver struct {
-	ResourceURL string   `json:"resource_url,omitempty"`
-	Hostname    string   `json:"hostname"`
-	IP          []string `json:"ip,omitempty"`
-}
-
-// GetDomains retrieves all domains
-func (c *Client) GetDomains() ([]Domain, error) {
-	resp, err := c.doRequest("GET", "/domain", nil)
-	if err != nil {
-		return nil, err
-	}
-	var domains []Domain
-	if err := json.Unmarshal(resp, &domains); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return domains, nil
-}
-
-// GetDomain retrieves a specific domain
-func (c *Client) GetDomain(name string) (*Domain, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s", name), nil)
-	if err != nil {
-		return nil, err
-	}
-	// API returns array with single element
-	var domains []Domain
-	if err := json.Unmarshal(resp, &domains); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	if len(domains) == 0 {
-		return nil, fmt.Errorf("domain not found: %s", name)
-	}
-	return &domains[0], nil
-}
-
-// UpdateDomain updates a domain's settings
-func (c *Client) UpdateDomain(name string, update *DomainUpdate) (*Domain, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s", name), update)
-	if err != nil {
-		return nil, err
-	}
-	var domains []Domain
-	if err := json.Unmarshal(resp, &domains); err != nil {
+// parseMySQLPermissionResponse returns the first permission of an API
+// response
+func parseMySQLPermissionResponse(resp []byte, notFound string) (*MySQLPermission, error) {
+	var permissions []MySQLPermission
+	if err := json.Unmarshal(resp, &permissions); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	if len(domains) == 0 {
-		return nil, fmt.Errorf("domain not found: %s", name)
+	if len(permissions) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, StatusMessage: notFound}
 	}
-	return &domains[0], nil
+	return &permissions[0], nil
 }
 
-// GetDomainPreferences retrieves domain preferences
-func (c *Client) GetDomainPreferences(name string) (*DomainPreferences, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/preferences", name), nil)
+// GetMySQLPermission retrieves the permissions of a MySQL account on a
+// database
+func (c *Client) GetMySQLPermission(service, username, database string) (*MySQLPermission, error) {
+	resp, err := c.doRequest("GET", mysqlPath(service, "account", username, "permission", database), nil)
 	if err != nil {
 		return nil, err
 	}
-	var prefs []DomainPreferences
-	if err := json.Unmarshal(resp, &prefs); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	if len(prefs) == 0 {
-		return nil, fmt.Errorf("domain preferences not found: %s", name)
-	}
-	return &prefs[0], nil
+	return parseMySQLPermissionResponse(resp, fmt.Sprintf("MySQL permissions of %s not found on %s", username, database))
 }
 
-// UpdateDomainPreferences updates domain preferences
-func (c *Client) UpdateDomainPreferences(name string, prefs *DomainPreferences) (*DomainPreferences, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s/preferences", name), prefs)
+// SetMySQLPermission replaces the permissions of a MySQL account on a
+// database
+func (c *Client) SetMySQLPermission(service, username, database string, permissions []string) (*MySQLPermission, error) {
+	body := &MySQLPermission{Permissions: permissions}
+	resp, err := c.doRequest("PUT", mysqlPath(service, "account", username, "permission", database), body)
 	if err != nil {
 		return nil, err
 	}
-	var updated []DomainPreferences
-	if err := json.Unmarshal(resp, &updated); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	if len(updated) == 0 {
-		return nil, fmt.Errorf("domain preferences not found: %s", name)
-	}
-	return &updated[0], nil
+	return parseMySQLPermissionResponse(resp, fmt.Sprintf("MySQL permissions of %s not found on %s after update", username, database))
 }
 
-// GetDomainNameservers retrieves all nameservers for a domain
-func (c *Client) GetDomainNameservers(domain string) ([]DomainNameserver, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/nameserver", domain), nil)
-	if err != nil {
-		return nil, err
-	}
-	var nameservers []DomainNameserver
-	if err := json.Unmarshal(resp, &nameservers); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return nameservers, nil
-}
-
-// GetDomainNameserver retrieves a specific nameserver
-func (c *Client) GetDomainNameserver(domain, hostname string) (*DomainNameserver, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), nil)
-	if err != nil {
-		return nil, err
-	}
-	var nameservers []DomainNameserver
-	if err := json.Unmarshal(resp, &nameservers); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	if len(nameservers) == 0 {
-		return nil, fmt.Errorf("nameserver not found: %s", hostname)
-	}
-	return &nameservers[0], nil
-}
-
-// CreateDomainNameservers creates nameservers for a domain (replaces all)
-func (c *Client) CreateDomainNameservers(domain string, nameservers []DomainNameserver) ([]DomainNameserver, error) {
-	resp, err := c.doRequest("POST", fmt.Sprintf("/domain/%s/nameserver", domain), nameservers)
-	if err != nil {
-		return nil, err
-	}
-	var created []DomainNameserver
-	if err := json.Unmarshal(resp, &created); err != nil {
-		return nil, fmt.Errorf("error parsing response: %w", err)
-	}
-	return created, nil
+// DeleteMySQLPermission removes every permission of a MySQL account on a
+// database
+func (c *Client) DeleteMySQLPermission(service, username, database string) error {
+	_, err := c.doRequest("DELETE", mysqlPath(service, "account", username, "permission", database), nil)
+	return err
 }
 
-// UpdateDomainNameserver updates a specific nameserver
-func (c *Client) UpdateDomainNameserver(domain, hostname string, ns *DomainNameserver) (*DomainNameserver, error) {
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), ns)
+// GetMySQLPermissionOptions retrieves the permissions that can be granted to
+// a MySQL account
+func (c *Client) GetMySQLPermissionOptions(service, username string) ([]string, error) {
+	resp, err := c.doRequest("OPTIONS", mysqlPath(service, "account", username, "permission"), nil)
 	if err != nil {
 		return nil, err
 	}
-	var updated []DomainNameserver
-	if err := json.Unmarshal(resp, &updated); err != nil {
+	var permissions []string
+	if err := json.Unmarshal(resp, &permissions); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
-	if len(updated) == 0 {
-		return nil, fmt.Errorf("nameserver not found after update: %s", hostname)
-	}
-	return &updated[0], nil
-}
-
-// DeleteDomainNameserver deletes a nameserver
-func (c *Client) DeleteDomainNameserver(domain, hostname string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/domain/%s/nameserver/%s", domain, hostname), nil)
-	return err
+	return permissions, nil
 }
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -193,3 +197,907 @@ func TestCreateARecord_MockServer(t *testing.T) {
 		t.Error("expected server URL to be set")
 	}
 }
+
+func TestRecordNameMatches(t *testing.T) {
+	tests := []struct {
+		recordName string
+		name       string
+		expected   bool
+	}{
+		{"caddy", "caddy.example.com", true},
+		{"caddy.example.com", "caddy", true},
+		{"caddy.example.com", "caddy.example.com", true},
+		{"caddy", "caddy", true},
+		{"caddy", "www.example.com", false},
+		{"caddy", "caddy.example.org", false},
+	}
+
+	for _, tt := range tests {
+		if got := recordNameMatches("example.com", tt.recordName, tt.name); got != tt.expected {
+			t.Errorf("recordNameMatches(%q, %q): expected %v, got %v", tt.recordName, tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestRecords_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/mx":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "1", Name: "example.com", Destination: "mx1.example.com", Priority: 10},
+				{ID: "2", Name: "mail", Destination: "mx2.example.com", Priority: 20},
+			})
+		case r.Method == "POST" && r.URL.Path == "/dns/example.com/mx":
+			var record DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			record.ID = "3"
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]DNSRecord{record})
+		case r.Method == "DELETE" && r.URL.Path == "/dns/example.com/mx/2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	records := client.Records("MX")
+	ctx := context.Background()
+
+	found, err := records.FindByName(ctx, "example.com", "mail.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found == nil || found.ID != "2" {
+		t.Errorf("expected record 2, got %+v", found)
+	}
+
+	created, err := records.Create(ctx, "example.com", &DNSRecord{Name: "backup", Destination: "mx3.example.com", Priority: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != "3" || created.Priority != 30 {
+		t.Errorf("unexpected created record: %+v", created)
+	}
+
+	if err := records.Delete(ctx, "example.com", "2"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateDNSZone_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/dns/example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		if _, ok := body["active"]; ok {
+			t.Error("expected unset active field to be omitted")
+		}
+		if body["ipv6"] != true {
+			t.Errorf("expected ipv6 true, got %v", body["ipv6"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]DNSZone{{
+			ResourceURL: "https://api.zone.eu/v2/dns/example.com",
+			Active:      true,
+			IPv6:        true,
+		}})
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	ipv6 := true
+	zone, err := client.UpdateDNSZone("example.com", &DNSZoneUpdate{IPv6: &ipv6})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !zone.Active || !zone.IPv6 {
+		t.Errorf("unexpected zone: %+v", zone)
+	}
+}
+
+func TestPagination_MockServer(t *testing.T) {
+	all := make([]Domain, 250)
+	for i := range all {
+		all[i] = Domain{Name: fmt.Sprintf("example%03d.com", i)}
+	}
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Order-By") != "name" || r.Header.Get("X-Order-Dir") != "asc" {
+			t.Errorf("expected name ordering, got %q %q", r.Header.Get("X-Order-By"), r.Header.Get("X-Order-Dir"))
+		}
+		limit, _ := strconv.Atoi(r.Header.Get("X-Pager-Limit"))
+		page, _ := strconv.Atoi(r.Header.Get("X-Pager-Page"))
+		if limit != 100 {
+			t.Errorf("expected page size 100, got %d", limit)
+		}
+
+		start := (page - 1) * limit
+		end := min(start+limit, len(all))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Pager-Enabled", "1")
+		w.Header().Set("X-Pager-Page", strconv.Itoa(page))
+		w.Header().Set("X-Pager-Pages", "3")
+		w.Header().Set("X-Pager-Limit", strconv.Itoa(limit))
+		json.NewEncoder(w).Encode(all[start:end])
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	domains, err := client.GetDomains()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(domains) != len(all) {
+		t.Errorf("expected %d domains, got %d", len(all), len(domains))
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	if domains[249].Name != "example249.com" {
+		t.Errorf("unexpected last domain: %s", domains[249].Name)
+	}
+}
+
+func TestGetDomainsWithFilter_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domain" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("delegated"); got != "false" {
+			t.Errorf("expected delegated=false, got %q", got)
+		}
+		if got := r.URL.Query().Get("name"); got != "example" {
+			t.Errorf("expected name=example, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Domain{{Name: "example.com"}})
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	delegated := false
+	domains, err := client.GetDomainsWithFilter(&DomainFilter{Name: "example", Delegated: &delegated})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(domains) != 1 || domains[0].Name != "example.com" {
+		t.Errorf("unexpected domains: %+v", domains)
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Status-Message", "Validation failed")
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		messages    []string
+		fieldErrors map[string]string
+	}{
+		{"field error", 422, `{"name":"zone_conflict"}`, nil, map[string]string{"name": "zone_conflict"}},
+		{"wrapped in array", 422, `[{"destination":"invalid"}]`, nil, map[string]string{"destination": "invalid"}},
+		{"messages", 400, `{"messages":["bad request","try again"]}`, []string{"bad request", "try again"}, nil},
+		{"field error list", 422, `{"ip":["invalid","required"]}`, nil, map[string]string{"ip": "invalid, required"}},
+		{"not json", 500, `Internal Server Error`, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newAPIError(tt.status, header, []byte(tt.body))
+			if err.StatusCode != tt.status || err.StatusMessage != "Validation failed" || err.Body != tt.body {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if fmt.Sprint(err.Messages) != fmt.Sprint(tt.messages) {
+				t.Errorf("expected messages %v, got %v", tt.messages, err.Messages)
+			}
+			if fmt.Sprint(err.FieldErrors) != fmt.Sprint(tt.fieldErrors) {
+				t.Errorf("expected field errors %v, got %v", tt.fieldErrors, err.FieldErrors)
+			}
+		})
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	notFound := &APIError{StatusCode: 404}
+	conflict := &APIError{StatusCode: 422, FieldErrors: map[string]string{"name": "zone_conflict"}}
+	invalid := &APIError{StatusCode: 422, FieldErrors: map[string]string{"destination": "invalid"}}
+	payment := &APIError{StatusCode: 402}
+	// A destination containing "404" must not be mistaken for a 404 response
+	tricky := &APIError{StatusCode: 422, Body: `{"destination":"404.example.com is invalid"}`}
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) || IsNotFound(tricky) {
+		t.Error("IsNotFound returned unexpected result")
+	}
+	if !IsNotFound(fmt.Errorf("wrapped: %w", notFound)) {
+		t.Error("IsNotFound should unwrap errors")
+	}
+	if !IsConflict(conflict) || !IsConflict(&APIError{StatusCode: 409}) || IsConflict(invalid) {
+		t.Error("IsConflict returned unexpected result")
+	}
+	if !IsValidation(invalid) || IsValidation(notFound) {
+		t.Error("IsValidation returned unexpected result")
+	}
+	if !IsPaymentRequired(payment) || IsPaymentRequired(invalid) {
+		t.Error("IsPaymentRequired returned unexpected result")
+	}
+	if IsNotFound(fmt.Errorf("API error (status 404)")) {
+		t.Error("plain errors should not be treated as API errors")
+	}
+}
+
+func TestRetry_MockServer(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		failures         []int
+		expectedAttempts int
+		expectErr        bool
+	}{
+		{"GET retried on 503", "GET", []int{503, 502}, 3, false},
+		{"POST not retried on 503", "POST", []int{503}, 1, true},
+		{"POST retried on 409", "POST", []int{409}, 2, false},
+		{"PUT not retried on 422", "PUT", []int{422}, 1, true},
+		{"gives up after max retries", "DELETE", []int{500, 500, 500, 500}, 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts <= len(tt.failures) {
+					w.WriteHeader(tt.failures[attempts-1])
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			client := NewClient("testuser", "testapikey")
+			client.baseURL = server.URL
+			client.SetRetryPolicy(2, time.Millisecond)
+
+			_, err := client.doRequestWithContext(context.Background(), tt.method, "/dns/example.com/a", nil)
+			if tt.expectErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := NewClient("testuser", "testapikey")
+	client.SetRetryPolicy(5, 5*time.Second)
+
+	for attempt, maxDelay := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second} {
+		delay := client.retryDelay(attempt, &APIError{StatusCode: 503})
+		if delay < maxDelay/2 || delay > maxDelay {
+			t.Errorf("attempt %d: expected delay between %v and %v, got %v", attempt, maxDelay/2, maxDelay, delay)
+		}
+	}
+
+	if delay := client.retryDelay(1, &RateLimitError{RetryAfter: 42 * time.Second}); delay != 42*time.Second {
+		t.Errorf("expected Retry-After delay, got %v", delay)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	client := NewClient("testuser", "testapikey")
+	client.rateLimitLimit = 600
+	client.SetRequestsPerMinute(600) // one token every 100ms
+	ctx := context.Background()
+
+	// The burst is available immediately
+	start := time.Now()
+	for i := 0; i < rateLimitBurst; i++ {
+		if err := client.waitForRateLimit(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("burst should not wait, took %v", elapsed)
+	}
+
+	// Then requests are paced
+	start = time.Now()
+	for i := 0; i < 2; i++ {
+		if err := client.waitForRateLimit(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected requests to be paced, took %v", elapsed)
+	}
+
+	// A lower X-Ratelimit-Remaining drains the bucket
+	client.updateRateLimitInfo(&http.Response{Header: http.Header{
+		"X-Ratelimit-Limit":     []string{"600"},
+		"X-Ratelimit-Remaining": []string{"0"},
+	}})
+	if client.tokens != 0 {
+		t.Errorf("expected empty bucket, got %v tokens", client.tokens)
+	}
+
+	// Waiting honors context cancellation
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := client.waitForRateLimit(cancelled); err == nil {
+		t.Error("expected context error")
+	}
+}
+
+func TestRateLimiterUsesLowerServerLimit(t *testing.T) {
+	client := NewClient("testuser", "testapikey")
+	client.SetRequestsPerMinute(120)
+	client.updateRateLimitInfo(&http.Response{Header: http.Header{"X-Ratelimit-Limit": []string{"30"}}})
+
+	if rate := client.rate(); rate != 30 {
+		t.Errorf("expected rate 30, got %d", rate)
+	}
+}
+
+func TestRecordCache_MockServer(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/a":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "1", Name: "www", Destination: "192.0.2.1"},
+				{ID: "2", Name: "api", Destination: "192.0.2.2"},
+			})
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/a/3":
+			json.NewEncoder(w).Encode([]DNSRecord{{ID: "3", Name: "new", Destination: "192.0.2.3"}})
+		case r.Method == "DELETE" && r.URL.Path == "/dns/example.com/a/2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	records := client.Records("A")
+	ctx := context.Background()
+
+	for _, id := range []string{"1", "2", "1"} {
+		record, err := records.Get(ctx, "example.com", id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if record.ID != id {
+			t.Errorf("expected record %s, got %s", id, record.ID)
+		}
+	}
+	if _, err := records.FindByName(ctx, "example.com", "www.example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := requests["GET /dns/example.com/a"]; n != 1 {
+		t.Errorf("expected 1 list request, got %d", n)
+	}
+
+	// Records missing from the listing are requested individually
+	if _, err := records.Get(ctx, "example.com", "3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := requests["GET /dns/example.com/a/3"]; n != 1 {
+		t.Errorf("expected 1 get request, got %d", n)
+	}
+
+	// Writes invalidate the cache
+	if err := records.Delete(ctx, "example.com", "2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := records.List(ctx, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := requests["GET /dns/example.com/a"]; n != 2 {
+		t.Errorf("expected 2 list requests after delete, got %d", n)
+	}
+
+	// The cache is shared by all clients of the same record type
+	if _, err := client.Records("a").List(ctx, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := requests["GET /dns/example.com/a"]; n != 2 {
+		t.Errorf("expected 2 list requests, got %d", n)
+	}
+}
+
+func TestDomainContacts_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/domain/example.com/contact":
+			json.NewEncoder(w).Encode([]DomainContact{
+				{Identificator: 1, Role: "registrant", Name: "Example OÜ"},
+				{Identificator: 2, Role: "tech", Name: "Jane Doe"},
+			})
+		case r.Method == "POST" && r.URL.Path == "/domain/example.com/contact":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if _, ok := body["identificator"]; ok {
+				t.Error("expected identificator to be omitted")
+			}
+			if _, ok := body["ext_ident_type"]; ok {
+				t.Error("expected empty ext_ident_type to be omitted")
+			}
+			if body["fax"] != "" {
+				t.Errorf("expected empty fax to be sent, got %v", body["fax"])
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]DomainContact{{Identificator: 3, Role: "admin", Email: body["email"].(string)}})
+		case r.Method == "POST" && r.URL.Path == "/domain/example.com/contact/tech/default":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]DomainContact{{Identificator: 4, Role: "tech"}})
+		case r.Method == "DELETE" && r.URL.Path == "/domain/example.com/contact/1":
+			w.WriteHeader(http.StatusPaymentRequired)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	contacts, err := client.GetDomainContacts("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contacts) != 2 || contacts[1].Role != "tech" || contacts[1].Identificator != 2 {
+		t.Errorf("unexpected contacts: %+v", contacts)
+	}
+
+	created, err := client.CreateDomainContact("example.com", &DomainContact{Role: "admin", Email: "admin@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Identificator != 3 || created.Email != "admin@example.com" {
+		t.Errorf("unexpected contact: %+v", created)
+	}
+
+	tech, err := client.CreateDefaultTechContact("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tech.Identificator != 4 {
+		t.Errorf("unexpected contact: %+v", tech)
+	}
+
+	if err := client.DeleteDomainContact("example.com", "1"); !IsPaymentRequired(err) {
+		t.Errorf("expected payment required error, got %v", err)
+	}
+}
+
+func TestDomainRenewal_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "OPTIONS" && r.URL.Path == "/domain/example.com":
+			w.Write([]byte(`{"renewal_options":[{"action":"renew","action_periods":[{"period":1,"expire_new":"2027-05-01","total_price_with_tax":12.4},{"period":2,"total_price_with_tax":24.8}]}]}`))
+		case r.Method == "POST" && r.URL.Path == "/order/domain/renew":
+			var body []map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if len(body) != 1 || body[0]["domain"] != "example.com" || body[0]["period"] != float64(2) {
+				t.Errorf("unexpected request body: %v", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`[{"identificator":"555","errors":null,"vat_rate":"24"}]`))
+		case r.Method == "GET" && r.URL.Path == "/order/domain/555":
+			w.Write([]byte(`[{"identificator":"555","errors":"1","vat_rate":"24"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	options, err := client.GetDomainOptions("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(options.RenewalOptions) != 1 || len(options.RenewalOptions[0].ActionPeriods) != 2 {
+		t.Fatalf("unexpected options: %+v", options)
+	}
+
+	order, err := client.RenewDomain("example.com", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.Identificator != "555" || order.VATRate != "24" || order.FailedRows() != "" {
+		t.Errorf("unexpected order: %+v", order)
+	}
+
+	order, err = client.GetDomainOrder("555")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order.FailedRows() != "1" {
+		t.Errorf("expected 1 failed row, got %q", order.FailedRows())
+	}
+}
+
+func TestMailAccounts_MockServer(t *testing.T) {
+	var updates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com":
+			if r.URL.Query().Get("addresses") != "true" {
+				t.Error("expected addresses=true query parameter")
+			}
+			json.NewEncoder(w).Encode([]MailAccount{{Address: "info@example.com", DiskUsage: 1024, Addresses: []string{"info@example.com", "hello@example.com"}}})
+		case r.Method == "POST" && r.URL.Path == "/vserver/example.com/mail/account":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if body["password"] != "correct-horse-battery" {
+				t.Errorf("expected password to be sent, got %v", body["password"])
+			}
+			for _, field := range []string{"disk_size", "addresses", "two_factor_auth"} {
+				if _, ok := body[field]; ok {
+					t.Errorf("expected %s to be omitted", field)
+				}
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]MailAccount{{Address: body["address"].(string)}})
+		case r.Method == "PUT" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com":
+			updates++
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if _, ok := body["password"]; ok {
+				t.Error("expected empty password to be omitted")
+			}
+			if fwd, ok := body["fwd_addresses"].([]interface{}); !ok || len(fwd) != 0 {
+				t.Errorf("expected empty fwd_addresses to be sent, got %v", body["fwd_addresses"])
+			}
+			json.NewEncoder(w).Encode([]MailAccount{{Address: "info@example.com"}})
+		case r.Method == "DELETE" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	created, err := client.CreateMailAccount("example.com", &MailAccount{Address: "info@example.com", Password: "correct-horse-battery"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Address != "info@example.com" {
+		t.Errorf("unexpected account: %+v", created)
+	}
+
+	account, err := client.GetMailAccount("example.com", "info@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if account.DiskUsage != 1024 || len(account.Addresses) != 2 {
+		t.Errorf("unexpected account: %+v", account)
+	}
+
+	if _, err := client.UpdateMailAccount("example.com", "info@example.com", &MailAccount{Address: "info@example.com", FwdAddresses: []string{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates != 1 {
+		t.Errorf("expected 1 update, got %d", updates)
+	}
+
+	if err := client.DeleteMailAccount("example.com", "info@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMailForwarderAutoreply_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/vserver/example.com/mail/forwarder":
+			var forwarder MailForwarder
+			if err := json.NewDecoder(r.Body).Decode(&forwarder); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]MailForwarder{forwarder})
+		case r.Method == "PUT" && r.URL.Path == "/vserver/example.com/mail/forwarder/sales@example.com/autoreply":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if body["dateend"] != "" {
+				t.Errorf("expected empty dateend to be sent to clear it, got %v", body["dateend"])
+			}
+			// The autoreply is returned as an object, not an array
+			json.NewEncoder(w).Encode(MailAutoreply{IsEnabled: true, Subject: body["subject"].(string)})
+		case r.Method == "GET" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com/autoreply":
+			json.NewEncoder(w).Encode([]MailAutoreply{{IsEnabled: false, Subject: "Away"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	forwarder, err := client.CreateMailForwarder("example.com", &MailForwarder{Address: "sales@example.com", FwdAddresses: []string{"jane@example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if forwarder.Address != "sales@example.com" || len(forwarder.FwdAddresses) != 1 {
+		t.Errorf("unexpected forwarder: %+v", forwarder)
+	}
+
+	autoreply, err := client.UpdateMailAutoreply("example.com", MailForwarderKind, "sales@example.com", &MailAutoreply{IsEnabled: true, Subject: "Closed for the holidays"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !autoreply.IsEnabled || autoreply.Subject != "Closed for the holidays" {
+		t.Errorf("unexpected autoreply: %+v", autoreply)
+	}
+
+	autoreply, err = client.GetMailAutoreply("example.com", MailAccountKind, "info@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if autoreply.IsEnabled || autoreply.Subject != "Away" {
+		t.Errorf("unexpected autoreply: %+v", autoreply)
+	}
+}
+
+func TestMailAccountAppPasswordsPremium_MockServer(t *testing.T) {
+	var premiumPackage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com/asp":
+			// last_used is false for a password that has never been used
+			w.Write([]byte(`[
+				{"identificator": 12, "type": "zmail", "name": "Phone", "scopes": ["imap", "smtp"], "created": "2026-01-01 10:00:00", "last_used": "2026-10-01 08:00:00"},
+				{"identificator": "13", "type": "zonecloud", "name": "Laptop", "scopes": [], "created": null, "last_used": false}
+			]`))
+		case r.Method == "DELETE" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com/asp/13":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "OPTIONS" && r.URL.Path == "/vserver/example.com/mail/account/@example.com/premium":
+			json.NewEncoder(w).Encode([]MailAccountPremium{
+				{Package: "premium-50", PackageAllowed: true, DiskSize: 53687091200, PriceMonth: 2.5, PriceYear: 30},
+				{Package: "premium-100", PackageAllowed: false, DiskSize: 107374182400, PriceMonth: 4, PriceYear: 48},
+			})
+		case r.Method == "PUT" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com/premium":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			premiumPackage = body["package"]
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/vserver/example.com/mail/account/info@example.com/premium":
+			json.NewEncoder(w).Encode([]MailAccountPremium{{Package: premiumPackage, PackageAllowed: true, DiskSizeHuman: "50 GB"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	passwords, err := client.GetMailAccountAppPasswords("example.com", "info@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(passwords) != 2 {
+		t.Fatalf("expected 2 passwords, got %d", len(passwords))
+	}
+	if passwords[0].Identificator.String() != "12" || passwords[0].LastUsedAt() != "2026-10-01 08:00:00" {
+		t.Errorf("unexpected password: %+v", passwords[0])
+	}
+	if passwords[1].Identificator.String() != "13" || passwords[1].LastUsedAt() != "" || passwords[1].Created != nil {
+		t.Errorf("unexpected password: %+v", passwords[1])
+	}
+
+	if err := client.DeleteMailAccountAppPassword("example.com", "info@example.com", "13"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	options, err := client.GetMailAccountPremiumOptions("example.com", "@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(options) != 2 || options[0].Package != "premium-50" || options[1].PackageAllowed {
+		t.Errorf("unexpected options: %+v", options)
+	}
+
+	if err := client.SetMailAccountPremium("example.com", "info@example.com", "premium-50"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	premium, err := client.GetMailAccountPremium("example.com", "info@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if premium.Package != "premium-50" || premium.DiskSizeHuman != "50 GB" {
+		t.Errorf("unexpected premium status: %+v", premium)
+	}
+}
+
+func TestMySQL_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "OPTIONS" && r.URL.Path == "/vserver/example.com/database/mysql":
+			json.NewEncoder(w).Encode([]string{"utf8mb4_unicode_ci", "utf8mb4_estonian_ci"})
+		case r.Method == "POST" && r.URL.Path == "/vserver/example.com/database/mysql":
+			var database MySQLDatabase
+			if err := json.NewDecoder(r.Body).Decode(&database); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			// The service's prefix is added to the name
+			database.Name = "d12345_" + database.Name
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]MySQLDatabase{database})
+		case r.Method == "POST" && r.URL.Path == "/vserver/example.com/database/mysql/account":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if body["password"] != "correct-horse-battery" || body["require_ssl"] != true {
+				t.Errorf("unexpected request body: %v", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]MySQLAccount{{Username: "d12345_app", Hosts: []string{"ws"}}})
+		case r.Method == "PUT" && r.URL.Path == "/vserver/example.com/database/mysql/account/d12345_app":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if _, ok := body["password"]; ok {
+				t.Error("expected an empty password not to be sent")
+			}
+			json.NewEncoder(w).Encode([]MySQLAccount{{Username: "d12345_app", Comment: body["comment"].(string), Hosts: []string{"ws", "pma"}}})
+		case r.Method == "OPTIONS" && r.URL.Path == "/vserver/example.com/database/mysql/account/d12345_app/permission":
+			json.NewEncoder(w).Encode([]string{"SELECT", "INSERT", "UPDATE", "DELETE"})
+		case r.Method == "PUT" && r.URL.Path == "/vserver/example.com/database/mysql/account/d12345_app/permission/d12345_shop":
+			var permission MySQLPermission
+			if err := json.NewDecoder(r.Body).Decode(&permission); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			json.NewEncoder(w).Encode([]MySQLPermission{{Username: "d12345_app", Database: "d12345_shop", Permissions: permission.Permissions}})
+		case r.Method == "GET" && r.URL.Path == "/vserver/example.com/database/mysql/account/d12345_app/permission/d12345_shop":
+			json.NewEncoder(w).Encode([]MySQLPermission{})
+		case r.Method == "DELETE" && r.URL.Path == "/vserver/example.com/database/mysql/d12345_shop":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+
+	collations, err := client.GetMySQLCollations("example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(collations) != 2 {
+		t.Errorf("unexpected collations: %v", collations)
+	}
+
+	database, err := client.CreateMySQLDatabase("example.com", &MySQLDatabase{Name: "shop", Collation: "utf8mb4_estonian_ci"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if database.Name != "d12345_shop" || database.Collation != "utf8mb4_estonian_ci" {
+		t.Errorf("unexpected database: %+v", database)
+	}
+
+	requireSSL := true
+	account, err := client.CreateMySQLAccount("example.com", &MySQLAccount{Username: "app", Password: "correct-horse-battery", RequireSSL: &requireSSL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if account.Username != "d12345_app" {
+		t.Errorf("unexpected account: %+v", account)
+	}
+
+	account, err = client.UpdateMySQLAccount("example.com", "d12345_app", &MySQLAccount{Username: "d12345_app", Comment: "Shop", Hosts: []string{"ws", "pma"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if account.Comment != "Shop" || len(account.Hosts) != 2 {
+		t.Errorf("unexpected account: %+v", account)
+	}
+
+	options, err := client.GetMySQLPermissionOptions("example.com", "d12345_app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(options) != 4 {
+		t.Errorf("unexpected permission options: %v", options)
+	}
+
+	permission, err := client.SetMySQLPermission("example.com", "d12345_app", "d12345_shop", []string{"SELECT", "INSERT"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if permission.Database != "d12345_shop" || len(permission.Permissions) != 2 {
+		t.Errorf("unexpected permission: %+v", permission)
+	}
+
+	_, err = client.GetMySQLPermission("example.com", "d12345_app", "d12345_shop")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error for an empty response, got %v", err)
+	}
+
+	if err := client.DeleteMySQLDatabase("example.com", "d12345_shop"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestParseAPITime(t *testing.T) {
+	expected := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"2026-11-01", "2026-11-01 00:00:00", "2026-11-01T00:00:00Z"} {
+		got, err := parseAPITime(value)
+		if err != nil {
+			t.Errorf("parseAPITime(%q): unexpected error: %v", value, err)
+			continue
+		}
+		if !got.Equal(expected) {
+			t.Errorf("parseAPITime(%q) = %v, expected %v", value, got, expected)
+		}
+	}
+
+	for _, value := range []string{"", "soon"} {
+		if _, err := parseAPITime(value); err == nil {
+			t.Errorf("parseAPITime(%q): expected an error", value)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &DNSRecordsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DNSRecordsDataSource{}
+)
+
+func NewDNSRecordsDataSource() datasource.DataSource {
+	return &DNSRecordsDataSource{}
+}
+
+// DNSRecordsDataSource lists the records of a zone without managing them
+type DNSRecordsDataSource struct {
+	client *Client
+}
+
+type DNSRecordsDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Zone             types.String `tfsdk:"zone"`
+	Types            types.List   `tfsdk:"types"`
+	Name             types.String `tfsdk:"name"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Destination      types.String `tfsdk:"destination"`
+	DestinationRegex types.String `tfsdk:"destination_regex"`
+	Records          types.List   `tfsdk:"records"`
+}
+
+func (d *DNSRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+// dnsRecordsAttrTypes returns the attribute types of a records element: the
+// attributes of a zoneeu_dns_zone_records element plus the record ID, FQDN and
+// whether Zone.EU allows deleting the record
+func dnsRecordsAttrTypes() map[string]attr.Type {
+	attrTypes := zoneRecordAttrTypes()
+	attrTypes["id"] = types.StringType
+	attrTypes["fqdn"] = types.StringType
+	attrTypes["deletable"] = types.BoolType
+	return attrTypes
+}
+
+func (d *DNSRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	recordAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the record in Zone.EU.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The record type.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The hostname of the record as returned by Zone.EU.",
+			Computed:    true,
+		},
+		"fqdn": schema.StringAttribute{
+			Description: "The fully qualified domain name of the record, lowercase and without a trailing dot.",
+			Computed:    true,
+		},
+		"destination": schema.StringAttribute{
+			Description: "The record value (address, hostname, text, URL, ...).",
+			Computed:    true,
+		},
+		"deletable": schema.BoolAttribute{
+			Description: "False for records managed by Zone.EU that cannot be deleted.",
+			Computed:    true,
+		},
+	}
+	for _, rt := range dnsRecordTypes {
+		for _, f := range rt.Fields {
+			if _, ok := recordAttributes[f.Attribute]; ok {
+				continue
+			}
+			description := fmt.Sprintf("%s records only. %s", rt.Type, f.Schema.GetDescription())
+			if f.intValue != nil {
+				recordAttributes[f.Attribute] = schema.Int64Attribute{Description: description, Computed: true}
+			} else {
+				recordAttributes[f.Attribute] = schema.StringAttribute{Description: description, Computed: true}
+			}
+		}
+	}
+	// priority is shared by MX and SRV records
+	recordAttributes["priority"] = schema.Int64Attribute{
+		Description: "MX and SRV records only. The priority of the target host (lower values have higher priority).",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the DNS records of a zone on Zone.EU, optionally filtered by type, name and destination. " +
+			"Filters are combined: a record must match every filter that is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the data source (same as zone).",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "The DNS zone name (domain name, e.g., example.com).",
+				Required:    true,
+			},
+			"types": schema.ListAttribute{
+				Description: "Only include records of these types: " + strings.Join(dnsRecordTypeNames(), ", ") + ". Defaults to every type.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(dnsRecordTypeNames()...)),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Only include records with this name. Relative names, FQDNs and \"@\" for the zone apex are accepted.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include records whose fqdn matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"destination": schema.StringAttribute{
+				Description: "Only include records with exactly this destination.",
+				Optional:    true,
+			},
+			"destination_regex": schema.StringAttribute{
+				Description: "Only include records whose destination matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "The matching records, ordered by type and then by name. Attributes that do not apply to a record's type are null.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSRecordsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	for _, attribute := range []string{"name_regex", "destination_regex"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := regexp.Compile(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Regular Expression", err.Error())
+		}
+	}
+}
+
+// dnsRecordFilter holds the filters of the zoneeu_dns_records data source.
+// Empty fields do not filter.
+type dnsRecordFilter struct {
+	// Name is compared with the canonical record name
+	Name             string
+	NameRegex        *regexp.Regexp
+	Destination      string
+	DestinationRegex *regexp.Regexp
+}
+
+// filterZoneRecords returns the records of zone matching every filter in f
+func filterZoneRecords(zone string, records []zoneRecord, f dnsRecordFilter) []zoneRecord {
+	var name string
+	if f.Name != "" {
+		name = canonicalRecordName(zone, f.Name)
+	}
+
+	var matched []zoneRecord
+	for _, zr := range records {
+		fqdn := canonicalRecordName(zone, zr.Record.Name)
+		if name != "" && fqdn != name {
+			continue
+		}
+		if f.NameRegex != nil && !f.NameRegex.MatchString(fqdn) {
+			continue
+		}
+		if f.Destination != "" && zr.Record.Destination != f.Destination {
+			continue
+		}
+		if f.DestinationRegex != nil && !f.DestinationRegex.MatchString(zr.Record.Destination) {
+			continue
+		}
+		matched = append(matched, zr)
+	}
+	return matched
+}
+
+func (d *DNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zone := data.Zone.ValueString()
+
+	recordTypes := dnsRecordTypes
+	if !data.Types.IsNull() {
+		var names []string
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		recordTypes = nil
+		for _, rt := range dnsRecordTypes {
+			for _, name := range names {
+				if strings.EqualFold(rt.Type, name) {
+					recordTypes = append(recordTypes, rt)
+					break
+				}
+			}
+		}
+	}
+
+	filter := dnsRecordFilter{
+		Name:        data.Name.ValueString(),
+		Destination: data.Destination.ValueString(),
+	}
+	var err error
+	if !data.NameRegex.IsNull() {
+		if filter.NameRegex, err = regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+	if !data.DestinationRegex.IsNull() {
+		if filter.DestinationRegex, err = regexp.Compile(data.DestinationRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("destination_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	live, err := listRecordsOfTypes(ctx, d.client, zone, recordTypes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading DNS Records",
+			fmt.Sprintf("Could not list records of zone %s: %s", zone, err),
+		)
+		return
+	}
+	matched := filterZoneRecords(zone, live, filter)
+
+	elems := make([]attr.Value, 0, len(matched))
+	for _, zr := range matched {
+		obj, diags := dnsRecordsElement(zone, zr)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elems = append(elems, obj)
+	}
+	records, diags := types.ListValue(types.ObjectType{AttrTypes: dnsRecordsAttrTypes()}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(zone)
+	data.Records = records
+
+	tflog.Trace(ctx, "read DNS records", map[string]interface{}{
+		"zone":    zone,
+		"records": len(elems),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dnsRecordsElement converts a record into a records element
+func dnsRecordsElement(zone string, zr zoneRecord) (types.Object, diag.Diagnostics) {
+	obj, diags := zoneRecordToObject(zr)
+	if diags.HasError() {
+		return obj, diags
+	}
+
+	deletable := zr.Record.Deletable == nil || *zr.Record.Deletable
+	attrs := obj.Attributes()
+	attrs["id"] = types.StringValue(zr.Record.ID)
+	attrs["fqdn"] = types.StringValue(canonicalRecordName(zone, zr.Record.Name))
+	attrs["deletable"] = types.BoolValue(deletable)
+	return types.ObjectValue(dnsRecordsAttrTypes(), attrs)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterZoneRecords(t *testing.T) {
+	records := []zoneRecord{
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "1", Name: "example.com", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "2", Name: "www.example.com", Destination: "192.0.2.2"}},
+		{Type: dnsRecordTypeTXT, Record: DNSRecord{ID: "3", Name: "example.com", Destination: "v=spf1 -all"}},
+		{Type: dnsRecordTypeMX, Record: DNSRecord{ID: "4", Name: "example.com", Destination: "mx.example.net", Priority: 10}},
+	}
+
+	tests := []struct {
+		name     string
+		filter   dnsRecordFilter
+		expected string
+	}{
+		{"no filters", dnsRecordFilter{}, "1,2,3,4"},
+		{"apex", dnsRecordFilter{Name: "@"}, "1,3,4"},
+		{"relative name", dnsRecordFilter{Name: "WWW"}, "2"},
+		{"fqdn", dnsRecordFilter{Name: "www.example.com."}, "2"},
+		{"name regex", dnsRecordFilter{NameRegex: regexp.MustCompile(`^www\.`)}, "2"},
+		{"destination", dnsRecordFilter{Destination: "v=spf1 -all"}, "3"},
+		{"destination regex", dnsRecordFilter{DestinationRegex: regexp.MustCompile(`^192\.0\.2\.`)}, "1,2"},
+		{"combined", dnsRecordFilter{Name: "@", DestinationRegex: regexp.MustCompile(`^192\.`)}, "1"},
+		{"no match", dnsRecordFilter{Name: "mail"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, zr := range filterZoneRecords("example.com", records, tt.filter) {
+				ids = append(ids, zr.Record.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDNSRecordsElement(t *testing.T) {
+	deletable := false
+	obj, diags := dnsRecordsElement("example.com", zoneRecord{
+		Type:   dnsRecordTypeMX,
+		Record: DNSRecord{ID: "4", Name: "Example.com", Destination: "mx.example.net", Priority: 10, Deletable: &deletable},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attrs := obj.Attributes()
+	if got := attrs["id"].(types.String).ValueString(); got != "4" {
+		t.Errorf("expected id 4, got %s", got)
+	}
+	if got := attrs["fqdn"].(types.String).ValueString(); got != "example.com" {
+		t.Errorf("expected fqdn example.com, got %s", got)
+	}
+	if got := attrs["priority"].(types.Int64).ValueInt64(); got != 10 {
+		t.Errorf("expected priority 10, got %d", got)
+	}
+	if attrs["deletable"].(types.Bool).ValueBool() {
+		t.Error("expected deletable false")
+	}
+	if !attrs["weight"].IsNull() {
+		t.Error("expected weight to be null for MX records")
+	}
+}
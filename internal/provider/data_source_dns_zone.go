@@ -21,8 +21,11 @@ type DNSZoneDataSource struct {
 }
 
 type DNSZoneDataSourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Active      types.Bool   `tfsdk:"active"`
+	IPv6        types.Bool   `tfsdk:"ipv6"`
+	ResourceURL types.String `tfsdk:"resource_url"`
 }
 
 func (d *DNSZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,6 +44,18 @@ func (d *DNSZoneDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "The DNS zone name (domain name, e.g., example.com).",
 				Required:    true,
 			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the zone is active.",
+				Computed:    true,
+			},
+			"ipv6": schema.BoolAttribute{
+				Description: "Whether IPv6 records are allowed for the zone.",
+				Computed:    true,
+			},
+			"resource_url": schema.StringAttribute{
+				Description: "The API URL of the zone.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -69,14 +84,16 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	// Validate zone exists by making an API call
-	_, err := d.client.GetZone(data.Name.ValueString())
+	zone, err := d.client.GetDNSZone(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
 		return
 	}
 
 	data.ID = data.Name
+	data.Active = types.BoolValue(zone.Active)
+	data.IPv6 = types.BoolValue(zone.IPv6)
+	data.ResourceURL = types.StringValue(zone.ResourceURL)
 
 	tflog.Trace(ctx, "read DNS zone data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &DNSZoneFileDataSource{}
+
+func NewDNSZoneFileDataSource() datasource.DataSource {
+	return &DNSZoneFileDataSource{}
+}
+
+type DNSZoneFileDataSource struct {
+	client *Client
+}
+
+type DNSZoneFileDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Zone    types.String `tfsdk:"zone"`
+	TTL     types.Int64  `tfsdk:"ttl"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (d *DNSZoneFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (d *DNSZoneFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports the records of a DNS zone on Zone.EU in RFC 1035 master-file (BIND) format. " +
+			"Zone.EU URL redirect records have no master-file representation and are included as comments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the data source (same as zone).",
+				Computed:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description: "The DNS zone name (domain name, e.g., example.com).",
+				Required:    true,
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "If set, a $TTL directive with this value is added to the zone file.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The zone in master-file format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DNSZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSZoneFileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	records, err := listZoneRecords(ctx, d.client, zone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list records of zone %s, got error: %s", zone, err))
+		return
+	}
+
+	data.ID = data.Zone
+	data.Content = types.StringValue(renderZoneFile(zone, records, int(data.TTL.ValueInt64())))
+
+	tflog.Trace(ctx, "read DNS zone file data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainContactDataSource{}
+
+type DomainContactDataSource struct {
+	client *Client
+}
+
+func NewDomainContactDataSource() datasource.DataSource {
+	return &DomainContactDataSource{}
+}
+
+func (d *DomainContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_contact"
+}
+
+func (d *DomainContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the data source in format 'domain/identificator'.",
+			Computed:    true,
+		},
+		"domain": schema.StringAttribute{
+			Description: "The domain name.",
+			Required:    true,
+		},
+		"identificator": schema.StringAttribute{
+			Description: "The ID of the contact in Zone.EU. Exactly one of identificator and role must be set.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("role")),
+			},
+		},
+		"role": schema.StringAttribute{
+			Description: "The contact role: " + strings.Join(domainContactRoles, ", ") + ". Looks up the domain's only contact with this role.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(domainContactRoles...),
+			},
+		},
+	}
+	for _, f := range domainContactFields {
+		attributes[f.Attribute] = schema.StringAttribute{
+			Description: f.Description,
+			Computed:    true,
+			Sensitive:   f.Sensitive,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a registrant, admin or tech contact of a domain in Zone.EU.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *DomainContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DomainContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var domain, id, role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("identificator"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contact *DomainContact
+	if !id.IsNull() {
+		var err error
+		contact, err = d.client.GetDomainContact(domain.ValueString(), id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Domain Contact",
+				fmt.Sprintf("Could not read contact %s for domain %s: %s", id.ValueString(), domain.ValueString(), err),
+			)
+			return
+		}
+	} else {
+		contacts, err := d.client.GetDomainContacts(domain.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Domain Contacts",
+				fmt.Sprintf("Could not read contacts for domain %s: %s", domain.ValueString(), err),
+			)
+			return
+		}
+
+		var ids []string
+		for i := range contacts {
+			if contacts[i].Role == role.ValueString() {
+				contact = &contacts[i]
+				ids = append(ids, strconv.Itoa(contacts[i].Identificator))
+			}
+		}
+		switch {
+		case len(ids) == 0:
+			resp.Diagnostics.AddError(
+				"Domain Contact Not Found",
+				fmt.Sprintf("Domain %s has no %s contact.", domain.ValueString(), role.ValueString()),
+			)
+			return
+		case len(ids) > 1:
+			resp.Diagnostics.AddError(
+				"Multiple Domain Contacts Found",
+				fmt.Sprintf("Domain %s has %d %s contacts (%s); set identificator to choose one.", domain.ValueString(), len(ids), role.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%d", domain.ValueString(), contact.Identificator))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(setDomainContactState(ctx, &resp.State, contact)...)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &DomainsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DomainsDataSource{}
+)
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+// DomainsDataSource lists the domains of the account, optionally filtered
+type DomainsDataSource struct {
+	client *Client
+}
+
+type DomainsDataSourceModel struct {
+	ID                 types.String                   `tfsdk:"id"`
+	NameRegex          types.String                   `tfsdk:"name_regex"`
+	ExpiringWithinDays types.Int64                    `tfsdk:"expiring_within_days"`
+	DNSSEC             types.Bool                     `tfsdk:"dnssec"`
+	NameserversCustom  types.Bool                     `tfsdk:"nameservers_custom"`
+	Delegated          types.Bool                     `tfsdk:"delegated"`
+	Names              []types.String                 `tfsdk:"names"`
+	Domains            []DomainsDataSourceDomainModel `tfsdk:"domains"`
+}
+
+type DomainsDataSourceDomainModel struct {
+	Name              types.String `tfsdk:"name"`
+	Expires           types.String `tfsdk:"expires"`
+	DNSSEC            types.Bool   `tfsdk:"dnssec"`
+	Autorenew         types.Bool   `tfsdk:"autorenew"`
+	NameserversCustom types.Bool   `tfsdk:"nameservers_custom"`
+	Delegated         types.String `tfsdk:"delegated"`
+	HasPendingDNSSEC  types.Bool   `tfsdk:"has_pending_dnssec"`
+	SigningRequired   types.Bool   `tfsdk:"signing_required"`
+}
+
+func (d *DomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the domains of the Zone.EU account, sorted by name. All pages of the API listing are fetched. " +
+			"Filters are combined: a domain must match every filter that is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the data source.",
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include domains whose name matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"expiring_within_days": schema.Int64Attribute{
+				Description: "Only include domains that expire within this many days, including domains that have already expired. Domains without a known expiry date are left out.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"dnssec": schema.BoolAttribute{
+				Description: "Only include domains with DNSSEC enabled (true) or disabled (false).",
+				Optional:    true,
+			},
+			"nameservers_custom": schema.BoolAttribute{
+				Description: "Only include domains with custom nameservers (true) or Zone.EU nameservers (false).",
+				Optional:    true,
+			},
+			"delegated": schema.BoolAttribute{
+				Description: "Only include domains delegated to you (true) or owned by you (false).",
+				Optional:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "The names of the matching domains, e.g. for use with for_each via toset().",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"domains": schema.ListNestedAttribute{
+				Description: "The matching domains.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The domain name.",
+							Computed:    true,
+						},
+						"expires": schema.StringAttribute{
+							Description: "When the domain expires.",
+							Computed:    true,
+						},
+						"dnssec": schema.BoolAttribute{
+							Description: "Whether DNSSEC is enabled for the domain.",
+							Computed:    true,
+						},
+						"autorenew": schema.BoolAttribute{
+							Description: "Whether autorenew is enabled for the domain.",
+							Computed:    true,
+						},
+						"nameservers_custom": schema.BoolAttribute{
+							Description: "Whether the domain uses custom nameservers.",
+							Computed:    true,
+						},
+						"delegated": schema.StringAttribute{
+							Description: "Username of the domain owner if the domain is delegated to you.",
+							Computed:    true,
+						},
+						"has_pending_dnssec": schema.BoolAttribute{
+							Description: "Whether the domain has a pending DNSSEC change.",
+							Computed:    true,
+						},
+						"signing_required": schema.BoolAttribute{
+							Description: "Whether signing is required for the domain.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DomainsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+	}
+}
+
+// domainFilter holds the client-side filters of the zoneeu_domains data
+// source. Nil fields do not filter.
+type domainFilter struct {
+	NameRegex         *regexp.Regexp
+	ExpiringWithin    *time.Duration
+	DNSSEC            *bool
+	NameserversCustom *bool
+	Delegated         *bool
+}
+
+// filterDomains returns the domains matching every filter in f. now is the
+// reference time for ExpiringWithin. Domains without a valid expiry date never
+// match ExpiringWithin.
+func filterDomains(ctx context.Context, domains []Domain, f domainFilter, now time.Time) []Domain {
+	var matched []Domain
+	for _, domain := range domains {
+		if f.NameRegex != nil && !f.NameRegex.MatchString(domain.Name) {
+			continue
+		}
+		if f.DNSSEC != nil && domain.DNSSEC != *f.DNSSEC {
+			continue
+		}
+		if f.NameserversCustom != nil && domain.NameserversCustom != *f.NameserversCustom {
+			continue
+		}
+		if f.Delegated != nil && (domain.Delegated != "") != *f.Delegated {
+			continue
+		}
+		if f.ExpiringWithin != nil {
+			expires, err := parseAPITime(domain.Expires)
+			if err != nil {
+				tflog.Warn(ctx, "skipping domain without a valid expiry date", map[string]interface{}{
+					"domain":  domain.Name,
+					"expires": domain.Expires,
+				})
+				continue
+			}
+			if expires.After(now.Add(*f.ExpiringWithin)) {
+				continue
+			}
+		}
+		matched = append(matched, domain)
+	}
+	return matched
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter domainFilter
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		filter.NameRegex = re
+	}
+	if !data.ExpiringWithinDays.IsNull() {
+		within := time.Duration(data.ExpiringWithinDays.ValueInt64()) * 24 * time.Hour
+		filter.ExpiringWithin = &within
+	}
+	if !data.DNSSEC.IsNull() {
+		filter.DNSSEC = data.DNSSEC.ValueBoolPointer()
+	}
+	if !data.NameserversCustom.IsNull() {
+		filter.NameserversCustom = data.NameserversCustom.ValueBoolPointer()
+	}
+	if !data.Delegated.IsNull() {
+		filter.Delegated = data.Delegated.ValueBoolPointer()
+	}
+
+	// The API filters by delegation itself, which saves fetching pages of
+	// domains that would be discarded anyway
+	domains, err := d.client.GetDomainsWithFilter(&DomainFilter{Delegated: filter.Delegated})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domains",
+			fmt.Sprintf("Could not list domains: %s", err),
+		)
+		return
+	}
+
+	domains = filterDomains(ctx, domains, filter, time.Now())
+
+	data.Names = make([]types.String, 0, len(domains))
+	data.Domains = make([]DomainsDataSourceDomainModel, 0, len(domains))
+	for _, domain := range domains {
+		delegated := types.StringNull()
+		if domain.Delegated != "" {
+			delegated = types.StringValue(domain.Delegated)
+		}
+
+		data.Names = append(data.Names, types.StringValue(domain.Name))
+		data.Domains = append(data.Domains, DomainsDataSourceDomainModel{
+			Name:              types.StringValue(domain.Name),
+			Expires:           types.StringValue(domain.Expires),
+			DNSSEC:            types.BoolValue(domain.DNSSEC),
+			Autorenew:         types.BoolValue(domain.Autorenew),
+			NameserversCustom: types.BoolValue(domain.NameserversCustom),
+			Delegated:         delegated,
+			HasPendingDNSSEC:  types.BoolValue(domain.HasPendingDNSSEC),
+			SigningRequired:   types.BoolValue(domain.SigningRequired),
+		})
+	}
+	data.ID = types.StringValue(domainsDataSourceID(&data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// domainsDataSourceID builds a stable ID from the filters
+func domainsDataSourceID(data *DomainsDataSourceModel) string {
+	parts := []string{"domains"}
+	if !data.NameRegex.IsNull() {
+		parts = append(parts, "name_regex="+data.NameRegex.ValueString())
+	}
+	if !data.ExpiringWithinDays.IsNull() {
+		parts = append(parts, fmt.Sprintf("expiring_within_days=%d", data.ExpiringWithinDays.ValueInt64()))
+	}
+	if !data.DNSSEC.IsNull() {
+		parts = append(parts, fmt.Sprintf("dnssec=%t", data.DNSSEC.ValueBool()))
+	}
+	if !data.NameserversCustom.IsNull() {
+		parts = append(parts, fmt.Sprintf("nameservers_custom=%t", data.NameserversCustom.ValueBool()))
+	}
+	if !data.Delegated.IsNull() {
+		parts = append(parts, fmt.Sprintf("delegated=%t", data.Delegated.ValueBool()))
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterDomains(t *testing.T) {
+	domains := []Domain{
+		{Name: "example.com", Expires: "2026-11-01", DNSSEC: true},
+		{Name: "example.org", Expires: "2027-06-01 00:00:00", NameserversCustom: true},
+		{Name: "example.ee", Expires: "2026-10-01T00:00:00Z", Delegated: "owner"},
+		{Name: "example.net"},
+		{Name: "example.eu", Expires: "soon"},
+	}
+	now := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	yes, no := true, false
+	thirtyDays := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		filter   domainFilter
+		expected string
+	}{
+		{"no filters", domainFilter{}, "example.com,example.org,example.ee,example.net,example.eu"},
+		{"name regex", domainFilter{NameRegex: regexp.MustCompile(`\.(com|ee)$`)}, "example.com,example.ee"},
+		{"expiring including expired", domainFilter{ExpiringWithin: &thirtyDays}, "example.com,example.ee"},
+		{"dnssec on", domainFilter{DNSSEC: &yes}, "example.com"},
+		{"dnssec off", domainFilter{DNSSEC: &no}, "example.org,example.ee,example.net,example.eu"},
+		{"custom nameservers", domainFilter{NameserversCustom: &yes}, "example.org"},
+		{"delegated", domainFilter{Delegated: &yes}, "example.ee"},
+		{"owned", domainFilter{Delegated: &no}, "example.com,example.org,example.net,example.eu"},
+		{"combined", domainFilter{ExpiringWithin: &thirtyDays, Delegated: &no}, "example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := filterDomains(context.Background(), domains, tt.filter, now)
+			var names []string
+			for _, d := range matched {
+				names = append(names, d.Name)
+			}
+			if got := strings.Join(names, ","); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource = &MailAccountAppPasswordsDataSource{}
+)
+
+func NewMailAccountAppPasswordsDataSource() datasource.DataSource {
+	return &MailAccountAppPasswordsDataSource{}
+}
+
+// MailAccountAppPasswordsDataSource lists the application specific passwords
+// of a mail account
+type MailAccountAppPasswordsDataSource struct {
+	client *Client
+}
+
+type MailAccountAppPasswordsDataSourceModel struct {
+	ID            types.String                  `tfsdk:"id"`
+	Service       types.String                  `tfsdk:"service"`
+	Address       types.String                  `tfsdk:"address"`
+	Type          types.String                  `tfsdk:"type"`
+	UnusedForDays types.Int64                   `tfsdk:"unused_for_days"`
+	IDs           []types.String                `tfsdk:"ids"`
+	AppPasswords  []MailAccountAppPasswordModel `tfsdk:"app_passwords"`
+}
+
+type MailAccountAppPasswordModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Type     types.String   `tfsdk:"type"`
+	Name     types.String   `tfsdk:"name"`
+	Scopes   []types.String `tfsdk:"scopes"`
+	Created  types.String   `tfsdk:"created"`
+	LastUsed types.String   `tfsdk:"last_used"`
+}
+
+// appPasswordTypes are the kinds of application specific passwords
+var appPasswordTypes = []string{"zmail", "zonecloud"}
+
+func (d *MailAccountAppPasswordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_account_app_passwords"
+}
+
+func (d *MailAccountAppPasswordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the application specific passwords of a Zone.EU mail account, e.g. to audit them or to find stale " +
+			"passwords to revoke with zoneeu_mail_account_app_password_revocation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the data source in format 'service/address'.",
+				Computed:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the mail account.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only include passwords of this type: " + strings.Join(appPasswordTypes, ", ") + ".",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(appPasswordTypes...),
+				},
+			},
+			"unused_for_days": schema.Int64Attribute{
+				Description: "Only include passwords that have not been used for at least this many days. " +
+					"Passwords that have never been used are included once they are this old.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Description: "The IDs of the matching passwords.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"app_passwords": schema.ListNestedAttribute{
+				Description: "The matching passwords.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the password.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the password: " + strings.Join(appPasswordTypes, ", ") + ".",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name given to the password.",
+							Computed:    true,
+						},
+						"scopes": schema.ListAttribute{
+							Description: "The scopes the password grants access to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"created": schema.StringAttribute{
+							Description: "When the password was created, if known.",
+							Computed:    true,
+						},
+						"last_used": schema.StringAttribute{
+							Description: "When the password was last used. Null if it has never been used.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MailAccountAppPasswordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// appPasswordFilter holds the filters of the zoneeu_mail_account_app_passwords
+// data source. Empty fields do not filter.
+type appPasswordFilter struct {
+	Type      string
+	UnusedFor *time.Duration
+}
+
+// filterAppPasswords returns the passwords matching every filter in f. now is
+// the reference time for UnusedFor.
+func filterAppPasswords(passwords []ApplicationPassword, f appPasswordFilter, now time.Time) ([]ApplicationPassword, error) {
+	var matched []ApplicationPassword
+	for _, password := range passwords {
+		if f.Type != "" && password.Type != f.Type {
+			continue
+		}
+		if f.UnusedFor != nil {
+			// A password that has never been used counts from its creation;
+			// without either date it is always considered stale
+			since := password.LastUsedAt()
+			if since == "" && password.Created != nil {
+				since = *password.Created
+			}
+			if since != "" {
+				t, err := parseAPITime(since)
+				if err != nil {
+					return nil, fmt.Errorf("application password %s: %w", password.Identificator, err)
+				}
+				if t.After(now.Add(-*f.UnusedFor)) {
+					continue
+				}
+			}
+		}
+		matched = append(matched, password)
+	}
+	return matched, nil
+}
+
+func (d *MailAccountAppPasswordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MailAccountAppPasswordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	filter := appPasswordFilter{Type: data.Type.ValueString()}
+	if !data.UnusedForDays.IsNull() {
+		unusedFor := time.Duration(data.UnusedForDays.ValueInt64()) * 24 * time.Hour
+		filter.UnusedFor = &unusedFor
+	}
+
+	passwords, err := d.client.GetMailAccountAppPasswords(service, address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Application Passwords",
+			fmt.Sprintf("Could not list application passwords of %s on %s: %s", address, service, err),
+		)
+		return
+	}
+
+	passwords, err = filterAppPasswords(passwords, filter, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Filtering Application Passwords",
+			fmt.Sprintf("Could not apply unused_for_days: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, address))
+	data.IDs = make([]types.String, 0, len(passwords))
+	data.AppPasswords = make([]MailAccountAppPasswordModel, 0, len(passwords))
+	for _, password := range passwords {
+		scopes := make([]types.String, 0, len(password.Scopes))
+		for _, scope := range password.Scopes {
+			scopes = append(scopes, types.StringValue(scope))
+		}
+		lastUsed := types.StringNull()
+		if v := password.LastUsedAt(); v != "" {
+			lastUsed = types.StringValue(v)
+		}
+
+		data.IDs = append(data.IDs, types.StringValue(password.Identificator.String()))
+		data.AppPasswords = append(data.AppPasswords, MailAccountAppPasswordModel{
+			ID:       types.StringValue(password.Identificator.String()),
+			Type:     types.StringValue(password.Type),
+			Name:     types.StringValue(password.Name),
+			Scopes:   scopes,
+			Created:  types.StringPointerValue(password.Created),
+			LastUsed: lastUsed,
+		})
+	}
+
+	tflog.Trace(ctx, "read application passwords", map[string]interface{}{
+		"service":   service,
+		"address":   address,
+		"passwords": len(passwords),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
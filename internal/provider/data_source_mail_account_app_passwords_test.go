@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterAppPasswords(t *testing.T) {
+	created := "2026-01-01 10:00:00"
+	recent := "2026-10-10"
+	passwords := []ApplicationPassword{
+		{Identificator: "1", Type: "zmail", Created: &created, LastUsed: json.RawMessage(`"2026-10-10 12:00:00"`)},
+		{Identificator: "2", Type: "zmail", Created: &created, LastUsed: json.RawMessage(`"2026-03-01T00:00:00Z"`)},
+		{Identificator: "3", Type: "zonecloud", Created: &created, LastUsed: json.RawMessage(`false`)},
+		{Identificator: "4", Type: "zonecloud", Created: &recent, LastUsed: json.RawMessage(`false`)},
+		{Identificator: "5", Type: "zmail", LastUsed: json.RawMessage(`false`)},
+	}
+	now := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	ninetyDays := 90 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		filter   appPasswordFilter
+		expected string
+	}{
+		{"no filters", appPasswordFilter{}, "1,2,3,4,5"},
+		{"type", appPasswordFilter{Type: "zonecloud"}, "3,4"},
+		{"unused", appPasswordFilter{UnusedFor: &ninetyDays}, "2,3,5"},
+		{"combined", appPasswordFilter{Type: "zmail", UnusedFor: &ninetyDays}, "2,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := filterAppPasswords(passwords, tt.filter, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var ids []string
+			for _, p := range matched {
+				ids = append(ids, p.Identificator.String())
+			}
+			if got := strings.Join(ids, ","); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+
+	bad := []ApplicationPassword{{Identificator: "6", LastUsed: json.RawMessage(`"yesterday"`)}}
+	if _, err := filterAppPasswords(bad, appPasswordFilter{UnusedFor: &ninetyDays}, now); err == nil {
+		t.Error("expected error for unparseable last_used date")
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addAPIError adds an error diagnostic for a failed API call. Field-level 422
+// validation errors for fields listed in attributes (API field name to
+// attribute path) are reported on that attribute, so Terraform points at the
+// offending configuration. Anything else is reported as a single error with
+// detail, which should already include err.
+func addAPIError(diags *diag.Diagnostics, summary, detail string, err error, attributes map[string]path.Path) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity || len(apiErr.FieldErrors) == 0 {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	fields := make([]string, 0, len(apiErr.FieldErrors))
+	for field := range apiErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	unmapped := false
+	for _, field := range fields {
+		attr, ok := attributes[field]
+		if !ok {
+			unmapped = true
+			continue
+		}
+		msg := fmt.Sprintf("Zone.EU rejected this value: %s", apiErr.FieldErrors[field])
+		if apiErr.StatusMessage != "" {
+			msg = fmt.Sprintf("%s (%s)", msg, apiErr.StatusMessage)
+		}
+		diags.AddAttributeError(attr, summary, msg)
+	}
+
+	if unmapped {
+		diags.AddError(summary, detail)
+	}
+}
+
+// rootAttributes maps API field names to the top-level attributes of the same
+// name
+func rootAttributes(names ...string) map[string]path.Path {
+	attributes := make(map[string]path.Path, len(names))
+	for _, name := range names {
+		attributes[name] = path.Root(name)
+	}
+	return attributes
+}
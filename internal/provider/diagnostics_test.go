@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAddAPIError(t *testing.T) {
+	attributes := dnsRecordTypeSSHFP.attributePaths()
+
+	tests := []struct {
+		name          string
+		err           error
+		expectedPaths []path.Path
+		expectedCount int
+	}{
+		{
+			name:          "field error mapped to attribute",
+			err:           &APIError{StatusCode: 422, FieldErrors: map[string]string{"destination": "invalid"}},
+			expectedPaths: []path.Path{path.Root("destination")},
+			expectedCount: 1,
+		},
+		{
+			name:          "API field renamed in schema",
+			err:           &APIError{StatusCode: 422, FieldErrors: map[string]string{"type": "invalid", "algorithm": "invalid"}},
+			expectedPaths: []path.Path{path.Root("algorithm"), path.Root("fingerprint_type")},
+			expectedCount: 2,
+		},
+		{
+			name:          "unknown field falls back to general error",
+			err:           &APIError{StatusCode: 422, FieldErrors: map[string]string{"name": "invalid", "other": "invalid"}},
+			expectedPaths: []path.Path{path.Root("name"), path.Empty()},
+			expectedCount: 2,
+		},
+		{
+			name:          "not a validation error",
+			err:           &APIError{StatusCode: 500},
+			expectedPaths: []path.Path{path.Empty()},
+			expectedCount: 1,
+		},
+		{
+			name:          "plain error",
+			err:           errors.New("connection refused"),
+			expectedPaths: []path.Path{path.Empty()},
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIError(&diags, "Client Error", "Unable to create SSHFP record", tt.err, attributes)
+
+			if diags.ErrorsCount() != tt.expectedCount {
+				t.Fatalf("expected %d errors, got %d: %v", tt.expectedCount, diags.ErrorsCount(), diags)
+			}
+			for i, d := range diags {
+				p := path.Empty()
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					p = withPath.Path()
+				}
+				if !p.Equal(tt.expectedPaths[i]) {
+					t.Errorf("diagnostic %d: expected path %s, got %s", i, tt.expectedPaths[i], p)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// recordConflict is a problem found when comparing a planned record with the
+// live records of its zone
+type recordConflict struct {
+	Summary string
+	Detail  string
+	Warning bool
+}
+
+// isZoneApex reports whether name refers to the zone apex
+func isZoneApex(zone, name string) bool {
+	return canonicalRecordName(zone, name) == canonicalRecordName(zone, "@")
+}
+
+// cnameAtApexDetail is the diagnostic detail for a CNAME record at the apex
+func cnameAtApexDetail(zone string) string {
+	return fmt.Sprintf("A CNAME record cannot be created at the zone apex (%s): the apex always has SOA and NS records, "+
+		"and a CNAME cannot coexist with other records of the same name. Use A/AAAA records or a URL record instead.", zone)
+}
+
+// conflictTypes returns the record types whose live records must be checked
+// before creating a record of type rt: every type for a CNAME, otherwise the
+// type itself and CNAME
+func conflictTypes(rt *dnsRecordType) []*dnsRecordType {
+	if rt == dnsRecordTypeCNAME {
+		return dnsRecordTypes
+	}
+	return []*dnsRecordType{rt, dnsRecordTypeCNAME}
+}
+
+// conflictPolicy is the on_conflict attribute of the DNS record resources:
+// what to do when records of the same type and name already exist
+type conflictPolicy string
+
+const (
+	// conflictPolicyError fails if any record of the same type and name exists
+	conflictPolicyError conflictPolicy = "error"
+	// conflictPolicyAdopt takes over an existing record, preferring an
+	// identical one, and updates it to match the configuration
+	conflictPolicyAdopt conflictPolicy = "adopt"
+	// conflictPolicyAdoptIfIdentical takes over an identical existing record
+	// and otherwise creates a new one next to the existing records
+	conflictPolicyAdoptIfIdentical conflictPolicy = "adopt_if_identical"
+	// conflictPolicyReplaceAll deletes every other record of the same type
+	// and name
+	conflictPolicyReplaceAll conflictPolicy = "replace_all"
+)
+
+// conflictPolicyNames returns the values accepted by on_conflict
+func conflictPolicyNames() []string {
+	return []string{
+		string(conflictPolicyError),
+		string(conflictPolicyAdopt),
+		string(conflictPolicyAdoptIfIdentical),
+		string(conflictPolicyReplaceAll),
+	}
+}
+
+// splitExistingRecords splits the existing records of type rt and the same
+// name as record into the first one identical to record and the others. The
+// record ownID (the record already managed by the resource) is skipped.
+func splitExistingRecords(zone string, rt *dnsRecordType, record *DNSRecord, ownID string, existing []DNSRecord) (identical *DNSRecord, others []DNSRecord) {
+	key := rt.recordKey(zone, record)
+	for i := range existing {
+		switch {
+		case existing[i].ID == ownID:
+		case identical == nil && rt.recordKey(zone, &existing[i]) == key:
+			identical = &existing[i]
+		default:
+			others = append(others, existing[i])
+		}
+	}
+	return identical, others
+}
+
+// findRecordConflicts compares a planned record of type rt with the live
+// records of its zone and returns the records Zone.EU would reject with
+// zone_conflict, and what the provider will do with existing records of the
+// same type and name under policy. The live record ownID is the record
+// already managed by the resource; it is set when the resource is renamed.
+func findRecordConflicts(zone string, rt *dnsRecordType, record *DNSRecord, ownID string, live []zoneRecord, policy conflictPolicy) []recordConflict {
+	name := canonicalRecordName(zone, record.Name)
+
+	var others, cnames []zoneRecord
+	var sameType []DNSRecord
+	for _, lr := range live {
+		if canonicalRecordName(zone, lr.Record.Name) != name {
+			continue
+		}
+		switch {
+		case lr.Type == rt:
+			sameType = append(sameType, lr.Record)
+		case lr.Type == dnsRecordTypeCNAME:
+			cnames = append(cnames, lr)
+		case rt == dnsRecordTypeCNAME:
+			others = append(others, lr)
+		}
+	}
+
+	var conflicts []recordConflict
+	if len(others) > 0 {
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Conflicting DNS Records",
+			Detail: fmt.Sprintf("%s already has other records in Zone.EU: %s. A CNAME record cannot coexist with other records of the same name, "+
+				"so Zone.EU would reject it with zone_conflict. Remove those records first; if this configuration removes them, apply that change separately before adding the CNAME.",
+				name, describeZoneRecords(others)),
+		})
+	}
+	if len(cnames) > 0 {
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Conflicting DNS Records",
+			Detail: fmt.Sprintf("%s already has a CNAME record in Zone.EU: %s. Other records cannot coexist with a CNAME of the same name, "+
+				"so Zone.EU would reject this %s record with zone_conflict. Remove the CNAME first; if this configuration removes it, apply that change separately before adding this record.",
+				name, describeZoneRecords(cnames), rt.Type),
+		})
+	}
+
+	identical, existing := splitExistingRecords(zone, rt, record, ownID, sameType)
+	all := existing
+	if identical != nil {
+		all = append([]DNSRecord{*identical}, existing...)
+	}
+	if len(all) == 0 {
+		return conflicts
+	}
+	importHint := fmt.Sprintf("Import it with: terraform import <address> %s/%s, or set on_conflict to choose how existing records are handled.", zone, all[0].ID)
+
+	switch {
+	case policy == conflictPolicyReplaceAll:
+		deleted := existing
+		if ownID != "" {
+			deleted = all
+		}
+		if len(deleted) > 0 {
+			conflicts = append(conflicts, recordConflict{
+				Summary: "Existing DNS Records Will Be Deleted",
+				Detail: fmt.Sprintf("%s already has %s records in Zone.EU. Because on_conflict is replace_all, these records will be deleted: %s.",
+					name, rt.Type, describeRecords(rt, deleted)),
+				Warning: true,
+			})
+		}
+		if identical != nil && ownID == "" {
+			conflicts = append(conflicts, adoptedConflict(name, rt, identical))
+		}
+	case ownID != "":
+		// A renamed record is updated in place, which Zone.EU rejects if it
+		// would duplicate an existing record
+		if identical != nil || (rt == dnsRecordTypeCNAME && len(existing) > 0) {
+			conflicts = append(conflicts, recordConflict{
+				Summary: "Duplicate DNS Record",
+				Detail: fmt.Sprintf("%s already has %s records in Zone.EU: %s. Renaming this record would create a duplicate; set on_conflict = \"replace_all\" to delete the existing records.",
+					name, rt.Type, describeRecords(rt, all)),
+			})
+		}
+	case policy == conflictPolicyError:
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Existing DNS Record",
+			Detail: fmt.Sprintf("%s already has %s records in Zone.EU: %s. %s",
+				name, rt.Type, describeRecords(rt, all), importHint),
+		})
+	case identical != nil:
+		conflicts = append(conflicts, adoptedConflict(name, rt, identical))
+	case policy == conflictPolicyAdopt:
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Existing DNS Record Will Be Adopted",
+			Detail: fmt.Sprintf("%s already has %s records in Zone.EU: %s. Because on_conflict is adopt, record %s will be taken over and updated to match the configuration.",
+				name, rt.Type, describeRecords(rt, existing), existing[0].ID),
+			Warning: true,
+		})
+	case rt == dnsRecordTypeCNAME:
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Duplicate DNS Record",
+			Detail: fmt.Sprintf("%s already has a CNAME record in Zone.EU: %s. A name can only have one CNAME record. %s",
+				name, describeRecords(rt, existing), importHint),
+		})
+	default:
+		// adopt_if_identical without an identical record, e.g. round-robin
+		// records: the record is created next to the existing ones
+		conflicts = append(conflicts, recordConflict{
+			Summary: "Existing DNS Records",
+			Detail: fmt.Sprintf("%d existing %s records for %s: %s. A new record will be created alongside them; "+
+				"set on_conflict to adopt or replace_all to take over or delete them instead.",
+				len(existing), rt.Type, name, describeRecords(rt, existing)),
+			Warning: true,
+		})
+	}
+
+	return conflicts
+}
+
+// adoptedConflict is the warning for an identical record that will be adopted
+// into state instead of being created
+func adoptedConflict(name string, rt *dnsRecordType, identical *DNSRecord) recordConflict {
+	return recordConflict{
+		Summary: "Existing DNS Record Will Be Adopted",
+		Detail: fmt.Sprintf("%s already has an identical record in Zone.EU: %s. It will be adopted into state instead of being created.",
+			name, describeRecords(rt, []DNSRecord{*identical})),
+		Warning: true,
+	}
+}
+
+// describeRecords formats records of one type for a diagnostic
+func describeRecords(rt *dnsRecordType, records []DNSRecord) string {
+	zoneRecords := make([]zoneRecord, 0, len(records))
+	for _, r := range records {
+		zoneRecords = append(zoneRecords, zoneRecord{Type: rt, Record: r})
+	}
+	return describeZoneRecords(zoneRecords)
+}
+
+// describeZoneRecords formats records for a diagnostic, e.g.
+// "A record 123 (192.0.2.1), TXT record 124 (v=spf1 -all)"
+func describeZoneRecords(records []zoneRecord) string {
+	parts := make([]string, 0, len(records))
+	for _, r := range records {
+		parts = append(parts, fmt.Sprintf("%s record %s (%s)", r.Type.Type, r.Record.ID, r.Record.Destination))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// listRecordsOfTypes retrieves the records of the given types in a zone
+func listRecordsOfTypes(ctx context.Context, client *Client, zone string, recordTypes []*dnsRecordType) ([]zoneRecord, error) {
+	var all []zoneRecord
+	for _, rt := range recordTypes {
+		records, err := client.Records(rt.Type).List(ctx, zone)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s records: %w", rt.Type, err)
+		}
+		for _, r := range records {
+			all = append(all, zoneRecord{Type: rt, Record: r})
+		}
+	}
+	return all, nil
+}
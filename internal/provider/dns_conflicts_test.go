@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsZoneApex(t *testing.T) {
+	for _, name := range []string{"@", "", "example.com", "Example.COM."} {
+		if !isZoneApex("example.com", name) {
+			t.Errorf("expected %q to be the apex", name)
+		}
+	}
+	for _, name := range []string{"www", "www.example.com", "example.org."} {
+		if isZoneApex("example.com", name) {
+			t.Errorf("expected %q not to be the apex", name)
+		}
+	}
+}
+
+func TestFindRecordConflicts(t *testing.T) {
+	live := []zoneRecord{
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "1", Name: "www", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeTXT, Record: DNSRecord{ID: "2", Name: "www", Destination: "v=spf1 -all"}},
+		{Type: dnsRecordTypeCNAME, Record: DNSRecord{ID: "3", Name: "blog", Destination: "example.org"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "4", Name: "other", Destination: "192.0.2.9"}},
+	}
+
+	tests := []struct {
+		name     string
+		rt       *dnsRecordType
+		record   DNSRecord
+		ownID    string
+		policy   conflictPolicy
+		expected []string // summaries, "!" prefix for warnings
+		detail   string
+	}{
+		{
+			name:     "CNAME alongside A and TXT",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "www.example.com", Destination: "example.org"},
+			expected: []string{"Conflicting DNS Records"},
+			detail:   "A record 1 (192.0.2.1), TXT record 2 (v=spf1 -all)",
+		},
+		{
+			name:     "A alongside CNAME",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "blog.example.com.", Destination: "192.0.2.1"},
+			expected: []string{"Conflicting DNS Records"},
+			detail:   "CNAME record 3 (example.org)",
+		},
+		{
+			name:     "duplicate CNAME",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "blog", Destination: "example.net"},
+			expected: []string{"Duplicate DNS Record"},
+			detail:   "example.com/3",
+		},
+		{
+			name:     "duplicate CNAME with adopt",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "blog", Destination: "example.net"},
+			policy:   conflictPolicyAdopt,
+			expected: []string{"!Existing DNS Record Will Be Adopted"},
+			detail:   "record 3 will be taken over",
+		},
+		{
+			name:     "duplicate CNAME with replace_all",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "blog", Destination: "example.net"},
+			policy:   conflictPolicyReplaceAll,
+			expected: []string{"!Existing DNS Records Will Be Deleted"},
+		},
+		{
+			name:     "identical record is adopted",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "WWW.example.com", Destination: "192.0.2.1"},
+			expected: []string{"!Existing DNS Record Will Be Adopted"},
+			detail:   "identical",
+		},
+		{
+			name:     "identical record with error",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "www", Destination: "192.0.2.1"},
+			policy:   conflictPolicyError,
+			expected: []string{"Existing DNS Record"},
+		},
+		{
+			name:     "round-robin A record",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "www", Destination: "192.0.2.2"},
+			expected: []string{"!Existing DNS Records"},
+			detail:   "1 existing A records for www.example.com: A record 1 (192.0.2.1). A new record will be created alongside them",
+		},
+		{
+			name:     "round-robin A record with error",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "www", Destination: "192.0.2.2"},
+			policy:   conflictPolicyError,
+			expected: []string{"Existing DNS Record"},
+		},
+		{
+			name:     "round-robin A record with replace_all",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "www", Destination: "192.0.2.2"},
+			policy:   conflictPolicyReplaceAll,
+			expected: []string{"!Existing DNS Records Will Be Deleted"},
+			detail:   "A record 1 (192.0.2.1)",
+		},
+		{
+			name:     "renamed onto identical record",
+			rt:       dnsRecordTypeA,
+			record:   DNSRecord{Name: "www", Destination: "192.0.2.1"},
+			ownID:    "4",
+			expected: []string{"Duplicate DNS Record"},
+		},
+		{
+			name:     "own record is ignored",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "blog", Destination: "example.net"},
+			ownID:    "3",
+			expected: nil,
+		},
+		{
+			name:     "no records at name",
+			rt:       dnsRecordTypeCNAME,
+			record:   DNSRecord{Name: "new", Destination: "example.org"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := tt.policy
+			if policy == "" {
+				policy = conflictPolicyAdoptIfIdentical
+			}
+			conflicts := findRecordConflicts("example.com", tt.rt, &tt.record, tt.ownID, live, policy)
+
+			var got []string
+			for _, c := range conflicts {
+				summary := c.Summary
+				if c.Warning {
+					summary = "!" + summary
+				}
+				got = append(got, summary)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			if tt.detail != "" && !strings.Contains(conflicts[0].Detail, tt.detail) {
+				t.Errorf("expected detail to contain %q, got %q", tt.detail, conflicts[0].Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// canonicalRecordName returns the canonical form of a record name in zone: a
+// lowercase FQDN without the trailing dot. The API returns short names
+// ("www") while configurations usually use FQDNs ("www.example.com"), so "@",
+// "", the short label, the FQDN and the FQDN with a trailing dot all map to
+// the same canonical name.
+func canonicalRecordName(zone, name string) string {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	absolute := strings.HasSuffix(name, ".")
+	name = strings.TrimSuffix(name, ".")
+
+	switch {
+	case name == "" || name == "@" || name == zone:
+		return zone
+	case strings.HasSuffix(name, "."+zone) || absolute:
+		return name
+	default:
+		return name + "." + zone
+	}
+}
+
+// recordNamesEqual reports whether two record names refer to the same host
+func recordNamesEqual(zone, a, b string) bool {
+	return canonicalRecordName(zone, a) == canonicalRecordName(zone, b)
+}
+
+// normalizedRecordName returns the name to store in state for a record the
+// API returned as apiName. The prior (configured) form is kept when it refers
+// to the same host, so switching between "www" and "www.example.com" in the
+// API or the configuration never causes a diff. Otherwise the canonical FQDN
+// is used.
+func normalizedRecordName(zone string, prior types.String, apiName string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && recordNamesEqual(zone, prior.ValueString(), apiName) {
+		return prior
+	}
+	return types.StringValue(canonicalRecordName(zone, apiName))
+}
+
+// fqdnPlanModifier computes the planned fqdn attribute from the zone and name
+// attributes, so the value is known during plan instead of after apply
+type fqdnPlanModifier struct{}
+
+var _ planmodifier.String = fqdnPlanModifier{}
+
+func (m fqdnPlanModifier) Description(ctx context.Context) string {
+	return "Computes the FQDN from the zone and name attributes."
+}
+
+func (m fqdnPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m fqdnPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var zone, name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if zone.IsUnknown() || name.IsUnknown() || zone.IsNull() || name.IsNull() {
+		return
+	}
+	resp.PlanValue = types.StringValue(canonicalRecordName(zone.ValueString(), name.ValueString()))
+}
+
+// equivalentNamePlanModifier keeps the name in state when the configured name
+// refers to the same host, e.g. "www" after importing "www.example.com", so
+// that writing the name differently does not plan an update. Terraform accepts
+// the prior value in place of an equivalent configured value.
+type equivalentNamePlanModifier struct{}
+
+var _ planmodifier.String = equivalentNamePlanModifier{}
+
+func (m equivalentNamePlanModifier) Description(ctx context.Context) string {
+	return "Keeps the name in state when the configured name refers to the same host."
+}
+
+func (m equivalentNamePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m equivalentNamePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+		return
+	}
+
+	var zone, stateZone types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zone"), &stateZone)...)
+	if resp.Diagnostics.HasError() || zone.IsUnknown() || !zone.Equal(stateZone) {
+		return
+	}
+
+	if recordNamesEqual(zone.ValueString(), req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCanonicalRecordName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"@", "example.com"},
+		{"", "example.com"},
+		{"example.com", "example.com"},
+		{"example.com.", "example.com"},
+		{"caddy", "caddy.example.com"},
+		{"caddy.example.com", "caddy.example.com"},
+		{"caddy.example.com.", "caddy.example.com"},
+		{"Caddy.Example.COM", "caddy.example.com"},
+		{"*.dev", "*.dev.example.com"},
+		{"_dmarc", "_dmarc.example.com"},
+		{"mail.example.org.", "mail.example.org"},
+	}
+
+	for _, tt := range tests {
+		if got := canonicalRecordName("example.com", tt.name); got != tt.expected {
+			t.Errorf("canonicalRecordName(%q): expected %q, got %q", tt.name, tt.expected, got)
+		}
+	}
+
+	if got := canonicalRecordName("Example.com.", "www"); got != "www.example.com" {
+		t.Errorf("expected zone to be normalized, got %q", got)
+	}
+}
+
+func TestNormalizedRecordName(t *testing.T) {
+	tests := []struct {
+		prior    types.String
+		apiName  string
+		expected string
+	}{
+		{types.StringValue("caddy.example.com"), "caddy", "caddy.example.com"},
+		{types.StringValue("caddy"), "caddy", "caddy"},
+		{types.StringValue("@"), "example.com", "@"},
+		{types.StringValue("caddy.example.com."), "caddy", "caddy.example.com."},
+		// Renamed outside of Terraform
+		{types.StringValue("caddy.example.com"), "www", "www.example.com"},
+		// Import
+		{types.StringNull(), "caddy", "caddy.example.com"},
+		{types.StringNull(), "example.com", "example.com"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizedRecordName("example.com", tt.prior, tt.apiName); got.ValueString() != tt.expected {
+			t.Errorf("normalizedRecordName(%s, %q): expected %q, got %q", tt.prior, tt.apiName, tt.expected, got.ValueString())
+		}
+	}
+}
+
+func TestEquivalentNamePlanModifier(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{Required: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"zone": tftypes.String, "name": tftypes.String}}
+	object := func(zone, name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"zone": tftypes.NewValue(tftypes.String, zone),
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		stateZone string
+		stateName string
+		planZone  string
+		planName  string
+		expected  string
+	}{
+		{"short label after import", "example.com", "www.example.com", "example.com", "www", "www.example.com"},
+		{"apex", "example.com", "example.com", "example.com", "@", "example.com"},
+		{"different host", "example.com", "www.example.com", "example.com", "api", "api"},
+		{"different zone", "example.com", "www.example.com", "example.org", "www", "www"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("name"),
+				Plan:       tfsdk.Plan{Schema: s, Raw: object(tt.planZone, tt.planName)},
+				State:      tfsdk.State{Schema: s, Raw: object(tt.stateZone, tt.stateName)},
+				PlanValue:  types.StringValue(tt.planName),
+				StateValue: types.StringValue(tt.stateName),
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			equivalentNamePlanModifier{}.PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.PlanValue.ValueString() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, resp.PlanValue.ValueString())
+			}
+		})
+	}
+}
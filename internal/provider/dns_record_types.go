@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// dnsRecordType describes a Zone.EU DNS record type. Every record resource is
+// built from one of these descriptors by newDNSRecordResource, so adding a new
+// record type only requires a new entry in dnsRecordTypes.
+type dnsRecordType struct {
+	// Type is the DNS record type (e.g. "A", "MX"). Its lowercase form is used
+	// for the API path and the resource type name.
+	Type string
+
+	// Description is the resource description
+	Description string
+
+	// NameDescription and DestinationDescription describe the common
+	// name and destination attributes for this record type
+	NameDescription        string
+	DestinationDescription string
+
+	// DestinationValidators validate the destination attribute
+	DestinationValidators []validator.String
+
+	// Fields are the type-specific attributes (priority, weight, tag, ...)
+	Fields []dnsRecordField
+}
+
+// dnsRecordField maps a type-specific resource attribute onto a DNSRecord field.
+// Exactly one of intValue and stringValue is set.
+type dnsRecordField struct {
+	Attribute string
+	Schema    schema.Attribute
+
+	// APIField is the API field name, if it differs from Attribute
+	APIField string
+
+	intValue    func(*DNSRecord) *int
+	stringValue func(*DNSRecord) *string
+}
+
+// intRecordField creates a required integer attribute stored in the DNSRecord
+// field returned by value
+func intRecordField(attribute, description string, value func(*DNSRecord) *int, validators ...validator.Int64) dnsRecordField {
+	return dnsRecordField{
+		Attribute: attribute,
+		Schema: schema.Int64Attribute{
+			Description: description,
+			Required:    true,
+			Validators:  validators,
+		},
+		intValue: value,
+	}
+}
+
+// stringRecordField creates a required string attribute stored in the
+// DNSRecord field returned by value
+func stringRecordField(attribute, description string, value func(*DNSRecord) *string, validators ...validator.String) dnsRecordField {
+	return dnsRecordField{
+		Attribute: attribute,
+		Schema: schema.StringAttribute{
+			Description: description,
+			Required:    true,
+			Validators:  validators,
+		},
+		stringValue: value,
+	}
+}
+
+// withAPIField sets the API field name of a field whose attribute is named
+// differently
+func (f dnsRecordField) withAPIField(name string) dnsRecordField {
+	f.APIField = name
+	return f
+}
+
+// attributePaths maps the API field names of this record type to resource
+// attribute paths, for reporting validation errors on the right attribute
+func (rt *dnsRecordType) attributePaths() map[string]path.Path {
+	attributes := rootAttributes("name", "destination")
+	for _, f := range rt.Fields {
+		apiField := f.APIField
+		if apiField == "" {
+			apiField = f.Attribute
+		}
+		attributes[apiField] = path.Root(f.Attribute)
+	}
+	return attributes
+}
+
+var (
+	dnsRecordTypeA = &dnsRecordType{
+		Type:                   "A",
+		Description:            "Manages a DNS A record on Zone.EU.",
+		NameDescription:        "The hostname for the A record (FQDN, e.g., www.example.com).",
+		DestinationDescription: "The IPv4 address the record points to.",
+		DestinationValidators: []validator.String{
+			stringvalidator.LengthAtLeast(7),
+			ipv4Validator{},
+		},
+	}
+
+	dnsRecordTypeAAAA = &dnsRecordType{
+		Type:                   "AAAA",
+		Description:            "Manages a DNS AAAA record on Zone.EU.",
+		NameDescription:        "The hostname for the AAAA record (FQDN, e.g., www.example.com).",
+		DestinationDescription: "The IPv6 address the record points to.",
+		DestinationValidators: []validator.String{
+			stringvalidator.LengthAtLeast(2),
+			ipv6Validator{},
+		},
+	}
+
+	dnsRecordTypeCNAME = &dnsRecordType{
+		Type:                   "CNAME",
+		Description:            "Manages a DNS CNAME record on Zone.EU.",
+		NameDescription:        "The hostname for the CNAME record (FQDN, e.g., blog.example.com).",
+		DestinationDescription: "The canonical hostname this record points to.",
+	}
+
+	dnsRecordTypeMX = &dnsRecordType{
+		Type:                   "MX",
+		Description:            "Manages a DNS MX record on Zone.EU.",
+		NameDescription:        "The hostname for the MX record (FQDN, e.g., example.com).",
+		DestinationDescription: "The mail server hostname.",
+		Fields: []dnsRecordField{
+			intRecordField("priority", "The priority of the mail server (lower values have higher priority).",
+				func(r *DNSRecord) *int { return &r.Priority },
+				int64validator.Between(0, 65535)),
+		},
+	}
+
+	dnsRecordTypeTXT = &dnsRecordType{
+		Type:                   "TXT",
+		Description:            "Manages a DNS TXT record on Zone.EU.",
+		NameDescription:        "The hostname for the TXT record (FQDN, e.g., example.com).",
+		DestinationDescription: "The text content of the record.",
+	}
+
+	dnsRecordTypeNS = &dnsRecordType{
+		Type:                   "NS",
+		Description:            "Manages a DNS NS record on Zone.EU.",
+		NameDescription:        "The hostname for the NS record (FQDN, e.g., subdomain.example.com).",
+		DestinationDescription: "The nameserver hostname.",
+	}
+
+	dnsRecordTypeSRV = &dnsRecordType{
+		Type:                   "SRV",
+		Description:            "Manages a DNS SRV record on Zone.EU.",
+		NameDescription:        "The service name (e.g., _sip._tcp.example.com).",
+		DestinationDescription: "The target server hostname.",
+		Fields: []dnsRecordField{
+			intRecordField("priority", "The priority of the target host (lower values have higher priority).",
+				func(r *DNSRecord) *int { return &r.Priority },
+				int64validator.Between(0, 65535)),
+			intRecordField("weight", "A relative weight for records with the same priority.",
+				func(r *DNSRecord) *int { return &r.Weight },
+				int64validator.Between(0, 65535)),
+			intRecordField("port", "The TCP or UDP port on which the service is found.",
+				func(r *DNSRecord) *int { return &r.Port },
+				int64validator.Between(0, 65535)),
+		},
+	}
+
+	dnsRecordTypeCAA = &dnsRecordType{
+		Type:                   "CAA",
+		Description:            "Manages a DNS CAA record on Zone.EU.",
+		NameDescription:        "The hostname for the CAA record (FQDN, e.g., example.com).",
+		DestinationDescription: "The value associated with the tag (e.g., CA domain).",
+		Fields: []dnsRecordField{
+			intRecordField("flag", "The CAA record flag (0-255). Commonly 0 for non-critical or 128 for critical.",
+				func(r *DNSRecord) *int { return &r.Flag },
+				int64validator.Between(0, 255)),
+			stringRecordField("tag", "The CAA tag: issue, issuewild, or iodef.",
+				func(r *DNSRecord) *string { return &r.Tag },
+				stringvalidator.OneOf("issue", "issuewild", "iodef")),
+		},
+	}
+
+	dnsRecordTypeTLSA = &dnsRecordType{
+		Type:                   "TLSA",
+		Description:            "Manages a DNS TLSA record on Zone.EU.",
+		NameDescription:        "The service name (e.g., _443._tcp.example.com for HTTPS).",
+		DestinationDescription: "The certificate association data (hash or full certificate).",
+		Fields: []dnsRecordField{
+			intRecordField("certificate_usage", "TLSA certificate usage field (0-3): 0=CA constraint, 1=Service cert constraint, 2=Trust anchor, 3=Domain-issued cert.",
+				func(r *DNSRecord) *int { return &r.CertificateUsage },
+				int64validator.Between(0, 3)),
+			intRecordField("selector", "TLSA selector field (0-1): 0=Full certificate, 1=SubjectPublicKeyInfo.",
+				func(r *DNSRecord) *int { return &r.Selector },
+				int64validator.Between(0, 1)),
+			intRecordField("matching_type", "TLSA matching type field (0-2): 0=Exact match, 1=SHA-256, 2=SHA-512.",
+				func(r *DNSRecord) *int { return &r.MatchingType },
+				int64validator.Between(0, 2)),
+		},
+	}
+
+	dnsRecordTypeSSHFP = &dnsRecordType{
+		Type:                   "SSHFP",
+		Description:            "Manages a DNS SSHFP record on Zone.EU.",
+		NameDescription:        "The hostname for the SSHFP record (FQDN, e.g., server.example.com).",
+		DestinationDescription: "The fingerprint in hexadecimal.",
+		Fields: []dnsRecordField{
+			intRecordField("algorithm", "The SSH key algorithm: 1=RSA, 2=DSA, 3=ECDSA, 4=Ed25519.",
+				func(r *DNSRecord) *int { return &r.Algorithm },
+				int64validator.Between(1, 4)),
+			intRecordField("fingerprint_type", "The fingerprint type: 1=SHA-1, 2=SHA-256.",
+				func(r *DNSRecord) *int { return &r.Type },
+				int64validator.Between(1, 2)).withAPIField("type"),
+		},
+	}
+
+	dnsRecordTypeURL = &dnsRecordType{
+		Type:                   "URL",
+		Description:            "Manages a DNS URL redirect record on Zone.EU.",
+		NameDescription:        "The hostname to redirect from (FQDN, e.g., old.example.com).",
+		DestinationDescription: "The URL to redirect to.",
+		DestinationValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^https?://`),
+				"must be a valid URL starting with http:// or https://",
+			),
+		},
+		Fields: []dnsRecordField{
+			intRecordField("redirect_type", "The HTTP redirect status code: 301 (permanent) or 302 (temporary).",
+				func(r *DNSRecord) *int { return &r.Type },
+				int64validator.OneOf(301, 302)).withAPIField("type"),
+		},
+	}
+)
+
+// dnsRecordTypes lists every supported record type
+var dnsRecordTypes = []*dnsRecordType{
+	dnsRecordTypeA,
+	dnsRecordTypeAAAA,
+	dnsRecordTypeCNAME,
+	dnsRecordTypeMX,
+	dnsRecordTypeTXT,
+	dnsRecordTypeNS,
+	dnsRecordTypeSRV,
+	dnsRecordTypeCAA,
+	dnsRecordTypeTLSA,
+	dnsRecordTypeSSHFP,
+	dnsRecordTypeURL,
+}
+
+// dnsRecordTypeByName returns the descriptor for a record type, or nil if the
+// type is not supported
+func dnsRecordTypeByName(recordType string) *dnsRecordType {
+	for _, rt := range dnsRecordTypes {
+		if strings.EqualFold(rt.Type, recordType) {
+			return rt
+		}
+	}
+	return nil
+}
+
+// dnsRecordTypeNames returns the names of all supported record types
+func dnsRecordTypeNames() []string {
+	names := make([]string, 0, len(dnsRecordTypes))
+	for _, rt := range dnsRecordTypes {
+		names = append(names, rt.Type)
+	}
+	return names
+}
+
+// zoneRecord is a DNS record together with its type
+type zoneRecord struct {
+	Type   *dnsRecordType
+	Record DNSRecord
+}
+
+// listZoneRecords retrieves the records of every supported type in a zone
+func listZoneRecords(ctx context.Context, client *Client, zone string) ([]zoneRecord, error) {
+	return listRecordsOfTypes(ctx, client, zone, dnsRecordTypes)
+}
+
+// recordKey returns a string identifying the content of a record: its type,
+// name relative to the zone, destination and type-specific fields. Two records
+// with the same key are interchangeable.
+func (rt *dnsRecordType) recordKey(zone string, record *DNSRecord) string {
+	parts := []string{
+		rt.Type,
+		canonicalRecordName(zone, record.Name),
+		record.Destination,
+	}
+	for _, f := range rt.Fields {
+		if f.intValue != nil {
+			parts = append(parts, strconv.Itoa(*f.intValue(record)))
+		} else {
+			parts = append(parts, *f.stringValue(record))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+func NewDNSARecordResource() resource.Resource     { return newDNSRecordResource(dnsRecordTypeA) }
+func NewDNSAAAARecordResource() resource.Resource  { return newDNSRecordResource(dnsRecordTypeAAAA) }
+func NewDNSCNAMERecordResource() resource.Resource { return newDNSRecordResource(dnsRecordTypeCNAME) }
+func NewDNSMXRecordResource() resource.Resource    { return newDNSRecordResource(dnsRecordTypeMX) }
+func NewDNSTXTRecordResource() resource.Resource   { return newDNSRecordResource(dnsRecordTypeTXT) }
+func NewDNSNSRecordResource() resource.Resource    { return newDNSRecordResource(dnsRecordTypeNS) }
+func NewDNSSRVRecordResource() resource.Resource   { return newDNSRecordResource(dnsRecordTypeSRV) }
+func NewDNSCAARecordResource() resource.Resource   { return newDNSRecordResource(dnsRecordTypeCAA) }
+func NewDNSTLSARecordResource() resource.Resource  { return newDNSRecordResource(dnsRecordTypeTLSA) }
+func NewDNSSSHFPRecordResource() resource.Resource { return newDNSRecordResource(dnsRecordTypeSSHFP) }
+func NewDNSURLRecordResource() resource.Resource   { return newDNSRecordResource(dnsRecordTypeURL) }
+
+// ipv4Validator validates that a string is a valid IPv4 address
+type ipv4Validator struct{}
+
+func (v ipv4Validator) Description(ctx context.Context) string {
+	return "value must be a valid IPv4 address"
+}
+
+func (v ipv4Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid IPv4 address"
+}
+
+func (v ipv4Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address",
+			fmt.Sprintf("The value %q is not a valid IPv4 address.", value),
+		)
+	}
+}
+
+// ipv6Validator validates that a string is a valid IPv6 address
+type ipv6Validator struct{}
+
+func (v ipv6Validator) Description(ctx context.Context) string {
+	return "value must be a valid IPv6 address"
+}
+
+func (v ipv6Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid IPv6 address"
+}
+
+func (v ipv6Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Address",
+			fmt.Sprintf("The value %q is not a valid IPv6 address.", value),
+		)
+	}
+}
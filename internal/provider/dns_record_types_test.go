@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDNSRecordTypes(t *testing.T) {
+	seen := map[string]bool{}
+	for _, rt := range dnsRecordTypes {
+		if seen[rt.Type] {
+			t.Errorf("duplicate record type %s", rt.Type)
+		}
+		seen[rt.Type] = true
+
+		for _, f := range rt.Fields {
+			if (f.intValue == nil) == (f.stringValue == nil) {
+				t.Errorf("%s field %s must map exactly one DNSRecord field", rt.Type, f.Attribute)
+			}
+		}
+	}
+}
+
+func TestDNSRecordResourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	for _, rt := range dnsRecordTypes {
+		r := newDNSRecordResource(rt)
+
+		metaResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "zoneeu"}, metaResp)
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema diagnostics: %v", metaResp.TypeName, schemaResp.Diagnostics)
+		}
+		if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid schema: %v", metaResp.TypeName, diags)
+		}
+		for _, f := range rt.Fields {
+			if _, ok := schemaResp.Schema.Attributes[f.Attribute]; !ok {
+				t.Errorf("%s: missing attribute %s", metaResp.TypeName, f.Attribute)
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GenerateConfig writes Terraform configuration for existing Zone.EU zones to
+// w: a resource block for every DNS record, the domain and its custom
+// nameservers, each with a Terraform 1.5+ import block so that `terraform
+// plan` adopts the existing objects instead of creating new ones.
+func GenerateConfig(ctx context.Context, client *Client, w io.Writer, zones []string) error {
+	g := &configGenerator{
+		client:     client,
+		w:          w,
+		usedLabels: make(map[string]map[string]bool),
+		multiZone:  len(zones) > 1,
+	}
+	for _, zone := range zones {
+		if err := g.generateZone(ctx, zone); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type configGenerator struct {
+	client *Client
+	w      io.Writer
+
+	// usedLabels tracks the resource labels per resource type
+	usedLabels map[string]map[string]bool
+	multiZone  bool
+}
+
+// hclAttribute is a single attribute of a generated resource block
+type hclAttribute struct {
+	Name  string
+	Value string // already rendered HCL expression
+}
+
+func (g *configGenerator) generateZone(ctx context.Context, zone string) error {
+	fmt.Fprintf(g.w, "# ==================== %s ====================\n\n", zone)
+
+	records, err := listZoneRecords(ctx, g.client, zone)
+	if err != nil {
+		return fmt.Errorf("zone %s: %w", zone, err)
+	}
+	for _, zr := range records {
+		if zr.Record.Deletable != nil && !*zr.Record.Deletable {
+			fmt.Fprintf(g.w, "# Skipped %s record %s -> %s: managed by Zone.EU\n\n", zr.Type.Type, zr.Record.Name, zr.Record.Destination)
+			continue
+		}
+
+		resourceType := "zoneeu_dns_" + strings.ToLower(zr.Type.Type) + "_record"
+		attributes := []hclAttribute{
+			{"zone", hclString(zone)},
+			{"name", hclString(canonicalRecordName(zone, zr.Record.Name))},
+			{"destination", hclString(zr.Record.Destination)},
+		}
+		for _, f := range zr.Type.Fields {
+			if f.intValue != nil {
+				attributes = append(attributes, hclAttribute{f.Attribute, strconv.Itoa(*f.intValue(&zr.Record))})
+			} else {
+				attributes = append(attributes, hclAttribute{f.Attribute, hclString(*f.stringValue(&zr.Record))})
+			}
+		}
+
+		label := g.label(resourceType, zone, relativeName(zone, zr.Record.Name))
+		g.writeResource(resourceType, label, attributes, fmt.Sprintf("%s/%s", zone, zr.Record.ID))
+	}
+
+	domain, err := g.client.GetDomain(zone)
+	if err != nil {
+		if IsNotFound(err) {
+			fmt.Fprintf(g.w, "# Domain %s is not registered with this account, skipping domain settings\n\n", zone)
+			return nil
+		}
+		return fmt.Errorf("domain %s: %w", zone, err)
+	}
+	prefs, err := g.client.GetDomainPreferences(zone)
+	if err != nil {
+		return fmt.Errorf("domain %s preferences: %w", zone, err)
+	}
+
+	domainLabel := g.label("zoneeu_domain", zone, "@")
+	g.writeResource("zoneeu_domain", domainLabel, []hclAttribute{
+		{"name", hclString(domain.Name)},
+		{"autorenew", strconv.FormatBool(domain.Autorenew)},
+		{"dnssec", strconv.FormatBool(domain.DNSSEC)},
+		{"renewal_notifications", strconv.FormatBool(prefs.RenewalNotifications)},
+		{"nameservers_custom", strconv.FormatBool(domain.NameserversCustom)},
+	}, domain.Name)
+
+	if !domain.NameserversCustom {
+		return nil
+	}
+
+	nameservers, err := g.client.GetDomainNameservers(zone)
+	if err != nil {
+		return fmt.Errorf("domain %s nameservers: %w", zone, err)
+	}
+	for _, ns := range nameservers {
+		attributes := []hclAttribute{
+			{"domain", "zoneeu_domain." + domainLabel + ".name"},
+			{"hostname", hclString(ns.Hostname)},
+		}
+		if len(ns.IP) > 0 {
+			ips := make([]string, len(ns.IP))
+			for i, ip := range ns.IP {
+				ips[i] = hclString(ip)
+			}
+			attributes = append(attributes, hclAttribute{"ip", "[" + strings.Join(ips, ", ") + "]"})
+		}
+		label := g.label("zoneeu_domain_nameserver", zone, ns.Hostname)
+		g.writeResource("zoneeu_domain_nameserver", label, attributes, fmt.Sprintf("%s/%s", zone, ns.Hostname))
+	}
+
+	return nil
+}
+
+// writeResource writes a resource block and its import block. Attribute names
+// are padded so the output matches `terraform fmt`.
+func (g *configGenerator) writeResource(resourceType, label string, attributes []hclAttribute, importID string) {
+	width := 0
+	for _, a := range attributes {
+		if len(a.Name) > width {
+			width = len(a.Name)
+		}
+	}
+
+	fmt.Fprintf(g.w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, label, hclString(importID))
+	fmt.Fprintf(g.w, "resource %q %q {\n", resourceType, label)
+	for _, a := range attributes {
+		fmt.Fprintf(g.w, "  %-*s = %s\n", width, a.Name, a.Value)
+	}
+	fmt.Fprint(g.w, "}\n\n")
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// label returns a unique resource label for name within resourceType. When
+// generating several zones the zone is part of the label to avoid clashes.
+func (g *configGenerator) label(resourceType, zone, name string) string {
+	if name == "@" {
+		name = "apex"
+	}
+	name = strings.ReplaceAll(name, "*", "wildcard")
+	if g.multiZone {
+		name = zone + "_" + name
+	}
+
+	base := strings.Trim(invalidLabelChars.ReplaceAllString(name, "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') || base[0] == '-' {
+		base = "r_" + base
+	}
+
+	used := g.usedLabels[resourceType]
+	if used == nil {
+		used = make(map[string]bool)
+		g.usedLabels[resourceType] = used
+	}
+
+	label := base
+	for i := 2; used[label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[label] = true
+	return label
+}
+
+// hclString renders s as a quoted HCL string. Only the escapes HCL supports
+// are used, and template sequences are escaped as well.
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case unicode.IsPrint(r):
+			b.WriteRune(r)
+		case r > 0xFFFF:
+			fmt.Fprintf(&b, `\U%08X`, r)
+		default:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		}
+	}
+	b.WriteByte('"')
+
+	quoted := b.String()
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+	return quoted
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGenerateConfig_MockServer(t *testing.T) {
+	deletable := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/dns/example.com/a":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "1", Name: "example.com", Destination: "192.0.2.1"},
+				{ID: "2", Name: "www.example.com", Destination: "192.0.2.2"},
+			})
+		case "/dns/example.com/mx":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "3", Name: "example.com", Destination: "mx.example.com", Priority: 10},
+			})
+		case "/dns/example.com/txt":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "4", Name: "example.com", Destination: `v=spf1 include:${x} -all`},
+			})
+		case "/dns/example.com/ns":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "5", Name: "example.com", Destination: "ns1.zone.eu", Deletable: &deletable},
+			})
+		case "/domain/example.com":
+			json.NewEncoder(w).Encode([]Domain{{Name: "example.com", Autorenew: true, NameserversCustom: true}})
+		case "/domain/example.com/preferences":
+			json.NewEncoder(w).Encode([]DomainPreferences{{RenewalNotifications: true}})
+		case "/domain/example.com/nameserver":
+			json.NewEncoder(w).Encode([]DomainNameserver{{Hostname: "ns1.example.com", IP: []string{"192.0.2.53"}}})
+		default:
+			json.NewEncoder(w).Encode([]DNSRecord{})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	client.rateLimitLimit = 6000
+	client.SetRequestsPerMinute(6000)
+
+	var buf bytes.Buffer
+	if err := GenerateConfig(context.Background(), client, &buf, []string{"example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	expected := []string{
+		"import {\n  to = zoneeu_dns_a_record.apex\n  id = \"example.com/1\"\n}",
+		"resource \"zoneeu_dns_a_record\" \"www\" {\n  zone        = \"example.com\"\n  name        = \"www.example.com\"\n  destination = \"192.0.2.2\"\n}",
+		"  priority    = 10\n",
+		`destination = "v=spf1 include:$${x} -all"`,
+		"# Skipped NS record example.com -> ns1.zone.eu: managed by Zone.EU",
+		"to = zoneeu_domain.apex",
+		"  renewal_notifications = true\n",
+		"to = zoneeu_domain_nameserver.ns1_example_com\n  id = \"example.com/ns1.example.com\"",
+		"  domain   = zoneeu_domain.apex.name\n",
+		`  ip       = ["192.0.2.53"]`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Errorf("expected output to contain %q, got:\n%s", e, out)
+		}
+	}
+}
+
+func TestGenerateConfigLabels(t *testing.T) {
+	g := &configGenerator{usedLabels: make(map[string]map[string]bool)}
+
+	tests := []struct {
+		resourceType string
+		name         string
+		expected     string
+	}{
+		{"zoneeu_dns_a_record", "@", "apex"},
+		{"zoneeu_dns_a_record", "www", "www"},
+		{"zoneeu_dns_a_record", "www", "www_2"},
+		{"zoneeu_dns_aaaa_record", "www", "www"},
+		{"zoneeu_dns_txt_record", "_dmarc", "dmarc"},
+		{"zoneeu_dns_a_record", "*", "wildcard"},
+		{"zoneeu_dns_a_record", "*.dev", "wildcard_dev"},
+		{"zoneeu_dns_a_record", "1.api", "r_1_api"},
+	}
+	for _, tt := range tests {
+		if got := g.label(tt.resourceType, "example.com", tt.name); got != tt.expected {
+			t.Errorf("label(%q, %q) = %q, expected %q", tt.resourceType, tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{`v=spf1 include:_spf.zone.eu -all`, `"v=spf1 include:_spf.zone.eu -all"`},
+		{`say "hi"\now`, `"say \"hi\"\\now"`},
+		{"line1\nline2\r\tend", `"line1\nline2\r\tend"`},
+		{"bell\a and nul\x00", `"bell\u0007 and nul\u0000"`},
+		{"zero\u200bwidth", `"zero\u200Bwidth"`},
+		{"tõnu ü", `"tõnu ü"`},
+		{"${var} and %{if}", `"$${var} and %%{if}"`},
+	}
+	for _, tt := range tests {
+		if got := hclString(tt.value); got != tt.expected {
+			t.Errorf("hclString(%q) = %s, expected %s", tt.value, got, tt.expected)
+		}
+	}
+}
@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,8 +28,12 @@ type ZoneProvider struct {
 }
 
 type ZoneProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Username     types.String `tfsdk:"username"`
+	APIKey       types.String `tfsdk:"api_key"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	RequestsPerMinute types.Int64 `tfsdk:"requests_per_minute"`
 }
 
 func (p *ZoneProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,6 +54,27 @@ func (p *ZoneProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times a request is retried after rate limiting, 409 conflicts, server errors and network failures. Server errors and network failures are only retried for idempotent requests. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of API requests per minute, shared by all resources. Requests are paced evenly instead of being sent in bursts. The API allows 60 requests per minute per IP address, which is also the default; set a lower value when several Terraform runs share an IP address.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait between retries. Retries back off exponentially from one second with random jitter. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -79,6 +107,21 @@ func (p *ZoneProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	}
 
 	client := NewClient(username, apiKey)
+
+	retries := maxRetries
+	if !config.MaxRetries.IsNull() {
+		retries = int(config.MaxRetries.ValueInt64())
+	}
+	maxWait := retryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		maxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	client.SetRetryPolicy(retries, maxWait)
+
+	if !config.RequestsPerMinute.IsNull() {
+		client.SetRequestsPerMinute(int(config.RequestsPerMinute.ValueInt64()))
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -96,15 +139,34 @@ func (p *ZoneProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewDNSTLSARecordResource,
 		NewDNSSSHFPRecordResource,
 		NewDNSURLRecordResource,
+		NewDNSZoneRecordsResource,
+		NewDNSRecordSetResource,
+		NewDNSZoneResource,
 		NewDomainResource,
 		NewDomainNameserverResource,
+		NewDomainContactResource,
+		NewDomainRenewalResource,
+		NewMailAccountResource,
+		NewMailForwarderResource,
+		NewMailAutoreplyResource,
+		NewMailDKIMResource,
+		NewMailAccountPremiumResource,
+		NewMailAccountAppPasswordRevocationResource,
+		NewMySQLDatabaseResource,
+		NewMySQLUserResource,
+		NewMySQLGrantResource,
 	}
 }
 
 func (p *ZoneProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDNSZoneDataSource,
+		NewDNSZoneFileDataSource,
+		NewDNSRecordsDataSource,
 		NewDomainDataSource,
+		NewDomainContactDataSource,
+		NewDomainsDataSource,
+		NewMailAccountAppPasswordsDataSource,
 	}
 }
 
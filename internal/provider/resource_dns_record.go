@@ -0,0 +1,553 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &dnsRecordResource{}
+var _ resource.ResourceWithImportState = &dnsRecordResource{}
+var _ resource.ResourceWithValidateConfig = &dnsRecordResource{}
+var _ resource.ResourceWithModifyPlan = &dnsRecordResource{}
+
+// dnsRecordResource implements the zoneeu_dns_<type>_record resources. The
+// behaviour is identical for every record type; the descriptor supplies the
+// type-specific schema and field mapping.
+type dnsRecordResource struct {
+	client     *Client
+	recordType *dnsRecordType
+}
+
+func newDNSRecordResource(recordType *dnsRecordType) resource.Resource {
+	return &dnsRecordResource{recordType: recordType}
+}
+
+// dnsRecordResourceModel holds the attributes shared by all record resources.
+// The type-specific attributes are kept in their API form in record.
+type dnsRecordResourceModel struct {
+	ID            types.String
+	Zone          types.String
+	Name          types.String
+	Destination   types.String
+	RecordID      types.String
+	ForceRecreate types.Bool
+	OnConflict    types.String
+
+	record DNSRecord
+}
+
+// attributeGetter is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+func (r *dnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_" + strings.ToLower(r.recordType.Type) + "_record"
+}
+
+func (r *dnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of this resource in format zone/record_id.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"zone": schema.StringAttribute{
+			Description: "The DNS zone name (domain name, e.g., example.com).",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: r.recordType.NameDescription + " The short label, the FQDN, a trailing dot and `@` (or an empty string) for the zone apex are treated as the same name.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				equivalentNamePlanModifier{},
+			},
+		},
+		"fqdn": schema.StringAttribute{
+			Description: "The fully qualified domain name of the record, in lowercase without the trailing dot.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				fqdnPlanModifier{},
+			},
+		},
+		"destination": schema.StringAttribute{
+			Description: r.recordType.DestinationDescription,
+			Required:    true,
+			Validators:  r.recordType.DestinationValidators,
+		},
+		"record_id": schema.StringAttribute{
+			Description: "The ID of the record in Zone.EU.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"force_recreate": schema.BoolAttribute{
+			Description:        "Deprecated: use on_conflict = \"replace_all\" instead. If true and on_conflict is not set, behaves like on_conflict = \"replace_all\". Default: false.",
+			DeprecationMessage: "Use on_conflict = \"replace_all\" instead.",
+			Optional:           true,
+			Computed:           true,
+			Default:            booldefault.StaticBool(false),
+		},
+		"on_conflict": schema.StringAttribute{
+			Description: "What to do on create when records of the same type and name already exist in the zone: " +
+				"`error` fails, `adopt` takes over an existing record (an identical one if there is one) and updates it to match the configuration, " +
+				"`adopt_if_identical` takes over an identical record and otherwise creates a new record next to the existing ones, " +
+				"`replace_all` deletes every other record of the same type and name. Default: `adopt_if_identical`.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(conflictPolicyNames()...),
+				stringvalidator.ConflictsWith(path.MatchRoot("force_recreate")),
+			},
+		},
+	}
+	for _, f := range r.recordType.Fields {
+		attributes[f.Attribute] = f.Schema
+	}
+
+	resp.Schema = schema.Schema{
+		Description: r.recordType.Description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *dnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r.recordType != dnsRecordTypeCNAME {
+		return
+	}
+
+	var zone, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || zone.IsUnknown() || zone.IsNull() || name.IsUnknown() || name.IsNull() {
+		return
+	}
+
+	if isZoneApex(zone.ValueString(), name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid CNAME Record", cnameAtApexDetail(zone.ValueString()))
+	}
+}
+
+// ModifyPlan checks a record that is about to be created, or renamed, against
+// the live records of its zone, so that conflicts Zone.EU would reject with
+// zone_conflict are reported during plan instead of apply. Records created
+// or deleted by other resources in the same run are not visible here.
+func (r *dnsRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	plan, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Zone.IsUnknown() || plan.Name.IsUnknown() || plan.Destination.IsUnknown() {
+		return
+	}
+	zone := plan.Zone.ValueString()
+
+	var ownID string
+	if !req.State.Raw.IsNull() {
+		state, diags := r.getModel(ctx, req.State)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if recordNamesEqual(zone, state.Name.ValueString(), plan.Name.ValueString()) {
+			return
+		}
+		ownID = state.RecordID.ValueString()
+	}
+
+	live, err := listRecordsOfTypes(ctx, r.client, zone, conflictTypes(r.recordType))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check for Conflicting Records",
+			fmt.Sprintf("Could not list the records of zone %s, conflicts will only be detected during apply: %s", zone, err),
+		)
+		return
+	}
+
+	for _, c := range findRecordConflicts(zone, r.recordType, plan.toRecord(), ownID, live, plan.conflictPolicy()) {
+		if c.Warning {
+			resp.Diagnostics.AddAttributeWarning(path.Root("name"), c.Summary, c.Detail)
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), c.Summary, c.Detail)
+		}
+	}
+}
+
+// getModel reads the resource attributes from a plan, state or config
+func (r *dnsRecordResource) getModel(ctx context.Context, src attributeGetter) (*dnsRecordResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := &dnsRecordResourceModel{}
+
+	diags.Append(src.GetAttribute(ctx, path.Root("id"), &data.ID)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("zone"), &data.Zone)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("name"), &data.Name)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("destination"), &data.Destination)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("record_id"), &data.RecordID)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("force_recreate"), &data.ForceRecreate)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("on_conflict"), &data.OnConflict)...)
+
+	for _, f := range r.recordType.Fields {
+		if f.intValue != nil {
+			var v types.Int64
+			diags.Append(src.GetAttribute(ctx, path.Root(f.Attribute), &v)...)
+			*f.intValue(&data.record) = int(v.ValueInt64())
+		} else {
+			var v types.String
+			diags.Append(src.GetAttribute(ctx, path.Root(f.Attribute), &v)...)
+			*f.stringValue(&data.record) = v.ValueString()
+		}
+	}
+
+	return data, diags
+}
+
+// setState writes the resource attributes to state
+func (r *dnsRecordResource) setState(ctx context.Context, state *tfsdk.State, data *dnsRecordResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("name"), data.Name)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("fqdn"), types.StringValue(canonicalRecordName(data.Zone.ValueString(), data.Name.ValueString())))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("destination"), data.Destination)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("record_id"), data.RecordID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("force_recreate"), data.ForceRecreate)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("on_conflict"), data.OnConflict)...)
+
+	for _, f := range r.recordType.Fields {
+		if f.intValue != nil {
+			diags.Append(state.SetAttribute(ctx, path.Root(f.Attribute), types.Int64Value(int64(*f.intValue(&data.record))))...)
+		} else {
+			diags.Append(state.SetAttribute(ctx, path.Root(f.Attribute), types.StringValue(*f.stringValue(&data.record)))...)
+		}
+	}
+
+	return diags
+}
+
+// conflictPolicy returns the effective on_conflict policy. The deprecated
+// force_recreate attribute maps to replace_all.
+func (data *dnsRecordResourceModel) conflictPolicy() conflictPolicy {
+	switch {
+	case !data.OnConflict.IsNull() && !data.OnConflict.IsUnknown():
+		return conflictPolicy(data.OnConflict.ValueString())
+	case data.ForceRecreate.ValueBool():
+		return conflictPolicyReplaceAll
+	default:
+		return conflictPolicyAdoptIfIdentical
+	}
+}
+
+// toRecord builds the API payload from the model
+func (data *dnsRecordResourceModel) toRecord() *DNSRecord {
+	record := data.record
+	record.ID = ""
+	record.Name = data.Name.ValueString()
+	record.Destination = data.Destination.ValueString()
+	return &record
+}
+
+// fromRecord copies an API record into the model. The name is only replaced
+// if it refers to a different host than the current one.
+func (data *dnsRecordResourceModel) fromRecord(record *DNSRecord) {
+	data.Name = normalizedRecordName(data.Zone.ValueString(), data.Name, record.Name)
+	data.Destination = types.StringValue(record.Destination)
+	data.RecordID = types.StringValue(record.ID)
+	data.record = *record
+}
+
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType := r.recordType.Type
+	records := r.client.Records(recordType)
+	zone := data.Zone.ValueString()
+	record := data.toRecord()
+	policy := data.conflictPolicy()
+
+	existing, err := records.FindAllByName(ctx, zone, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check for existing %s records, got error: %s", recordType, err))
+		return
+	}
+	identical, others := splitExistingRecords(zone, r.recordType, record, "", existing)
+
+	var adopted *DNSRecord
+	switch policy {
+	case conflictPolicyError:
+		if len(existing) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Existing DNS Record",
+				fmt.Sprintf("%s already has %s records in Zone.EU: %s. Import the record or set on_conflict to choose how existing records are handled.",
+					data.Name.ValueString(), recordType, describeRecords(r.recordType, existing)),
+			)
+			return
+		}
+	case conflictPolicyAdopt:
+		adopted = identical
+		if adopted == nil && len(others) > 0 {
+			tflog.Info(ctx, fmt.Sprintf("on_conflict=adopt: updating existing %s record instead of creating new", recordType), map[string]interface{}{
+				"zone":      zone,
+				"name":      data.Name.ValueString(),
+				"record_id": others[0].ID,
+			})
+			adopted, err = records.Update(ctx, zone, others[0].ID, record)
+			if err != nil {
+				addAPIError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to update existing %s record %s for on_conflict=adopt, got error: %s", recordType, others[0].ID, err), err, r.recordType.attributePaths())
+				return
+			}
+		}
+	case conflictPolicyAdoptIfIdentical:
+		adopted = identical
+	case conflictPolicyReplaceAll:
+		if !r.deleteRecords(ctx, &resp.Diagnostics, zone, others) {
+			return
+		}
+		adopted = identical
+	}
+
+	if adopted == nil {
+		adopted, err = records.Create(ctx, zone, record)
+		if err != nil {
+			// Zone.EU rejects exact duplicates with zone_conflict. Adopt the
+			// existing record only if its content matches the configuration,
+			// so an unrelated record is never taken over unnoticed.
+			if IsConflict(err) && policy != conflictPolicyError {
+				if existing, findErr := records.FindAllByName(ctx, zone, data.Name.ValueString()); findErr == nil {
+					adopted, _ = splitExistingRecords(zone, r.recordType, record, "", existing)
+				}
+			}
+			if adopted == nil {
+				addAPIError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to create %s record, got error: %s", recordType, err), err, r.recordType.attributePaths())
+				return
+			}
+		}
+	}
+
+	if identical != nil && adopted == identical {
+		tflog.Info(ctx, fmt.Sprintf("adopted identical existing %s record into state", recordType), map[string]interface{}{
+			"zone":      zone,
+			"name":      data.Name.ValueString(),
+			"record_id": adopted.ID,
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", zone, adopted.ID))
+	data.RecordID = types.StringValue(adopted.ID)
+
+	tflog.Trace(ctx, fmt.Sprintf("created %s record", recordType))
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data)...)
+}
+
+// replaceExisting deletes every record of the same type and name as record
+// except recordID, for on_conflict=replace_all on update
+func (r *dnsRecordResource) replaceExisting(ctx context.Context, diags *diag.Diagnostics, zone, recordID string, record *DNSRecord) bool {
+	existing, err := r.client.Records(r.recordType.Type).FindAllByName(ctx, zone, record.Name)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to check for existing %s records, got error: %s", r.recordType.Type, err))
+		return false
+	}
+	identical, others := splitExistingRecords(zone, r.recordType, record, recordID, existing)
+	if identical != nil {
+		others = append(others, *identical)
+	}
+	return r.deleteRecords(ctx, diags, zone, others)
+}
+
+// deleteRecords deletes records replaced by on_conflict=replace_all. Records
+// that are already gone are ignored; any other error is reported and stops
+// the operation.
+func (r *dnsRecordResource) deleteRecords(ctx context.Context, diags *diag.Diagnostics, zone string, records []DNSRecord) bool {
+	for _, rec := range records {
+		tflog.Info(ctx, fmt.Sprintf("on_conflict=replace_all: deleting existing %s record", r.recordType.Type), map[string]interface{}{
+			"zone":      zone,
+			"name":      rec.Name,
+			"record_id": rec.ID,
+		})
+		if err := r.client.Records(r.recordType.Type).Delete(ctx, zone, rec.ID); err != nil && !IsNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete existing %s record %s for on_conflict=replace_all, got error: %s", r.recordType.Type, rec.ID, err))
+			return false
+		}
+	}
+	return true
+}
+
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data, diags := r.getModel(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, recordID, err := parseRecordID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse ID: %s", err))
+		return
+	}
+
+	record, err := r.client.Records(r.recordType.Type).Get(ctx, zone, recordID)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s record, got error: %s", r.recordType.Type, err))
+		return
+	}
+
+	data.Zone = types.StringValue(zone)
+	data.fromRecord(record)
+
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data)...)
+}
+
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, recordID, err := parseRecordID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse ID: %s", err))
+		return
+	}
+
+	recordType := r.recordType.Type
+	records := r.client.Records(recordType)
+	record := data.toRecord()
+
+	// With on_conflict=replace_all, a renamed record replaces every other
+	// record of the same type at its new name, and any other update deletes
+	// them if Zone.EU reports a conflict
+	replaceAll := data.conflictPolicy() == conflictPolicyReplaceAll
+	replaced := false
+	if replaceAll {
+		var stateName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !recordNamesEqual(zone, stateName.ValueString(), data.Name.ValueString()) {
+			if !r.replaceExisting(ctx, &resp.Diagnostics, zone, recordID, record) {
+				return
+			}
+			replaced = true
+		}
+	}
+
+	_, err = records.Update(ctx, zone, recordID, record)
+	if IsConflict(err) && replaceAll && !replaced {
+		tflog.Info(ctx, "zone_conflict during update with on_conflict=replace_all, deleting conflicting records and retrying")
+		if !r.replaceExisting(ctx, &resp.Diagnostics, zone, recordID, record) {
+			return
+		}
+		_, err = records.Update(ctx, zone, recordID, record)
+	}
+	if err != nil {
+		detail := fmt.Sprintf("Unable to update %s record, got error: %s", recordType, err)
+		if IsConflict(err) && !replaceAll {
+			detail += ". Another record conflicts with the new values; set on_conflict = \"replace_all\" to delete conflicting records of the same type and name."
+		}
+		addAPIError(&resp.Diagnostics, "Client Error", detail, err, r.recordType.attributePaths())
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated %s record", recordType))
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, data)...)
+}
+
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data, diags := r.getModel(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, recordID, err := parseRecordID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse ID: %s", err))
+		return
+	}
+
+	err = r.client.Records(r.recordType.Type).Delete(ctx, zone, recordID)
+	if err != nil {
+		// Ignore 404 errors - resource is already deleted
+		if IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s record, got error: %s", r.recordType.Type, err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("deleted %s record", r.recordType.Type))
+}
+
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: zone/record_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_id"), parts[1])...)
+}
+
+// Helper function to parse composite ID
+func parseRecordID(id string) (zone, recordID string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid ID format: %s, expected: zone/record_id", id)
+	}
+	return parts[0], parts[1], nil
+}
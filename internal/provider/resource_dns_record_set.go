@@ -0,0 +1,649 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &DNSRecordSetResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordSetResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordSetResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSRecordSetResource{}
+)
+
+func NewDNSRecordSetResource() resource.Resource {
+	return &DNSRecordSetResource{}
+}
+
+// DNSRecordSetResource manages all records of one type and name (an RRset),
+// e.g. round-robin A records or several TXT records, as a single resource
+type DNSRecordSetResource struct {
+	client *Client
+}
+
+type DNSRecordSetResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Zone   types.String `tfsdk:"zone"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Values types.Set    `tfsdk:"values"`
+	FQDN   types.String `tfsdk:"fqdn"`
+}
+
+func (r *DNSRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
+}
+
+func (r *DNSRecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages all DNS records of one type and name on Zone.EU as a set of values, " +
+			"e.g. round-robin A records, several TXT records or a group of MX records. " +
+			"Records of that type and name that are not in values are deleted on update.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource in format zone/type/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "The DNS zone name (domain name, e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The hostname of the records (FQDN, e.g., www.example.com). The short label, the FQDN, a trailing dot and `@` (or an empty string) for the zone apex are treated as the same name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfNameChanged,
+						"Changing the name to a different host requires replacement.",
+						"Changing the name to a different host requires replacement.",
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The record type: " + strings.Join(dnsRecordTypeNames(), ", ") + ".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsRecordTypeNames()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.SetAttribute{
+				Description: "The record values in master-file order: the type-specific fields followed by the destination, " +
+					"e.g. `192.0.2.1` (A), `10 mail.example.com` (MX), `10 5 5060 sip.example.com` (SRV) or `0 issue letsencrypt.org` (CAA). " +
+					"TXT values are given unquoted.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Description: "The fully qualified domain name of the records, in lowercase without the trailing dot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					fqdnPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfNameChanged replaces the resource only if the new name
+// refers to a different host, not when it is just written differently
+func requiresReplaceIfNameChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var zone types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.RequiresReplace = !recordNamesEqual(zone.ValueString(), req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+func (r *DNSRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSRecordSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Values.IsUnknown() || data.Values.IsNull() {
+		return
+	}
+
+	rt := dnsRecordTypeByName(data.Type.ValueString())
+	if rt == nil {
+		return // reported by the type validator
+	}
+
+	for _, elem := range data.Values.Elements() {
+		value, ok := elem.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		record, err := parseRecordSetValue(rt, value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid Record Value", err.Error())
+			continue
+		}
+		resp.Diagnostics.Append(validateRecordSetValue(ctx, rt, value.ValueString(), &record)...)
+	}
+
+	if rt == dnsRecordTypeCNAME && len(data.Values.Elements()) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid Record Value", "A name can only have one CNAME record.")
+	}
+
+	if rt == dnsRecordTypeCNAME && !data.Zone.IsUnknown() && !data.Name.IsUnknown() && isZoneApex(data.Zone.ValueString(), data.Name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid CNAME Record", cnameAtApexDetail(data.Zone.ValueString()))
+	}
+}
+
+// ModifyPlan checks a record set that is about to be created, or moved to a
+// different name, against the live records of its zone, so that a CNAME next
+// to other records (or other records next to a CNAME) is reported during plan
+// instead of failing with zone_conflict at apply. Records created or deleted
+// by other resources in the same run are not visible here.
+func (r *DNSRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Zone.IsUnknown() || plan.Name.IsUnknown() || plan.Type.IsUnknown() {
+		return
+	}
+	zone := plan.Zone.ValueString()
+	rt := dnsRecordTypeByName(plan.Type.ValueString())
+	if rt == nil {
+		return // reported by the type validator
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state DNSRecordSetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Zone.ValueString() == zone && strings.EqualFold(state.Type.ValueString(), rt.Type) &&
+			recordNamesEqual(zone, state.Name.ValueString(), plan.Name.ValueString()) {
+			return
+		}
+	}
+
+	live, err := listRecordsOfTypes(ctx, r.client, zone, conflictTypes(rt))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check for Conflicting Records",
+			fmt.Sprintf("Could not list the records of zone %s, conflicts will only be detected during apply: %s", zone, err),
+		)
+		return
+	}
+
+	for _, c := range recordSetConflicts(zone, rt, plan.Name.ValueString(), live) {
+		if c.Warning {
+			resp.Diagnostics.AddAttributeWarning(path.Root("name"), c.Summary, c.Detail)
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), c.Summary, c.Detail)
+		}
+	}
+}
+
+// recordSetConflicts returns the conflicts of a record set of type rt and
+// name with the live records of its zone. Live records of the set's own type
+// and name belong to the set, so only records of other types are checked.
+func recordSetConflicts(zone string, rt *dnsRecordType, name string, live []zoneRecord) []recordConflict {
+	var others []zoneRecord
+	for _, lr := range live {
+		if lr.Type != rt {
+			others = append(others, lr)
+		}
+	}
+	return findRecordConflicts(zone, rt, &DNSRecord{Name: name}, "", others, conflictPolicyError)
+}
+
+// recordSetID returns the ID of a record set, which uses the canonical name so
+// that it does not depend on how the name is written
+func recordSetID(zone string, rt *dnsRecordType, name string) string {
+	return fmt.Sprintf("%s/%s/%s", zone, rt.Type, canonicalRecordName(zone, name))
+}
+
+// parseRecordSetValue parses a value in master-file order: the type-specific
+// fields separated by whitespace, followed by the destination. The destination
+// is the rest of the value, so TXT values keep their inner whitespace.
+func parseRecordSetValue(rt *dnsRecordType, value string) (DNSRecord, error) {
+	var record DNSRecord
+	tokens := strings.Fields(value)
+	rest := strings.TrimSpace(value)
+
+	for i, f := range rt.Fields {
+		if i >= len(tokens) {
+			return record, fmt.Errorf("%s value %q: missing %s", rt.Type, value, f.Attribute)
+		}
+		token := tokens[i]
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, token))
+
+		if f.intValue != nil {
+			n, err := strconv.Atoi(token)
+			if err != nil {
+				return record, fmt.Errorf("%s value %q: %s must be a number, got %q", rt.Type, value, f.Attribute, token)
+			}
+			*f.intValue(&record) = n
+		} else {
+			*f.stringValue(&record) = token
+		}
+	}
+
+	if rest == "" {
+		return record, fmt.Errorf("%s value %q: missing destination", rt.Type, value)
+	}
+	record.Destination = rest
+	return record, nil
+}
+
+// validateRecordSetValue runs the validators the single-record resources get
+// from the registry (ranges, tag names, address formats, ...) on a parsed
+// value
+func validateRecordSetValue(ctx context.Context, rt *dnsRecordType, value string, record *DNSRecord) diag.Diagnostics {
+	var found diag.Diagnostics
+	for _, f := range rt.Fields {
+		switch attribute := f.Schema.(type) {
+		case schema.Int64Attribute:
+			req := validator.Int64Request{Path: path.Root(f.Attribute), ConfigValue: types.Int64Value(int64(*f.intValue(record)))}
+			for _, v := range attribute.Validators {
+				resp := &validator.Int64Response{}
+				v.ValidateInt64(ctx, req, resp)
+				found.Append(resp.Diagnostics...)
+			}
+		case schema.StringAttribute:
+			req := validator.StringRequest{Path: path.Root(f.Attribute), ConfigValue: types.StringValue(*f.stringValue(record))}
+			for _, v := range attribute.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, req, resp)
+				found.Append(resp.Diagnostics...)
+			}
+		}
+	}
+
+	req := validator.StringRequest{Path: path.Root("destination"), ConfigValue: types.StringValue(record.Destination)}
+	for _, v := range rt.DestinationValidators {
+		resp := &validator.StringResponse{}
+		v.ValidateString(ctx, req, resp)
+		found.Append(resp.Diagnostics...)
+	}
+
+	var diags diag.Diagnostics
+	for _, d := range found.Errors() {
+		diags.AddAttributeError(path.Root("values"), d.Summary(), fmt.Sprintf("%s value %q: %s", rt.Type, value, d.Detail()))
+	}
+	return diags
+}
+
+// formatRecordSetValue renders a record as a record set value
+func formatRecordSetValue(rt *dnsRecordType, record *DNSRecord) string {
+	parts := make([]string, 0, len(rt.Fields)+1)
+	for _, f := range rt.Fields {
+		if f.intValue != nil {
+			parts = append(parts, strconv.Itoa(*f.intValue(record)))
+		} else {
+			parts = append(parts, *f.stringValue(record))
+		}
+	}
+	return strings.Join(append(parts, record.Destination), " ")
+}
+
+// recordSetValueKey identifies a record within a set. Addresses and hostnames
+// are compared in canonical form, so "mail.example.com." matches the
+// "mail.example.com" returned by the API.
+func recordSetValueKey(rt *dnsRecordType, record *DNSRecord) string {
+	r := *record
+	switch {
+	case rt == dnsRecordTypeA || rt == dnsRecordTypeAAAA:
+		if ip := net.ParseIP(r.Destination); ip != nil {
+			r.Destination = ip.String()
+		}
+	case zoneFileHostnameTypes[rt.Type]:
+		r.Destination = strings.ToLower(strings.TrimSuffix(r.Destination, "."))
+	}
+	return formatRecordSetValue(rt, &r)
+}
+
+// desiredRecords parses the configured values
+func (data *DNSRecordSetResourceModel) desiredRecords(ctx context.Context, rt *dnsRecordType) ([]DNSRecord, diag.Diagnostics) {
+	var values []string
+	diags := data.Values.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	records := make([]DNSRecord, 0, len(values))
+	for _, v := range values {
+		record, err := parseRecordSetValue(rt, v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("values"), "Invalid Record Value", err.Error())
+			continue
+		}
+		record.Name = canonicalRecordName(data.Zone.ValueString(), data.Name.ValueString())
+		records = append(records, record)
+	}
+	return records, diags
+}
+
+// reconcile deletes the live records that are not desired and creates the
+// desired records that don't exist yet. Records whose value did not change are
+// left alone, so reordering values causes no API calls.
+func (r *DNSRecordSetResource) reconcile(ctx context.Context, zone string, rt *dnsRecordType, desired, live []DNSRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+	records := r.client.Records(rt.Type)
+
+	existing := make(map[string][]DNSRecord)
+	for _, rec := range live {
+		key := recordSetValueKey(rt, &rec)
+		existing[key] = append(existing[key], rec)
+	}
+
+	var toCreate []DNSRecord
+	for _, rec := range desired {
+		key := recordSetValueKey(rt, &rec)
+		if matches := existing[key]; len(matches) > 0 {
+			existing[key] = matches[1:]
+			continue
+		}
+		toCreate = append(toCreate, rec)
+	}
+
+	// Delete first so that replacing a single-valued record (CNAME) does not
+	// conflict with the record it replaces
+	for _, leftover := range existing {
+		for _, rec := range leftover {
+			tflog.Debug(ctx, "deleting record not in record set", map[string]interface{}{
+				"zone":        zone,
+				"type":        rt.Type,
+				"name":        rec.Name,
+				"destination": rec.Destination,
+			})
+			if err := records.Delete(ctx, zone, rec.ID); err != nil && !IsNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to delete %s record %s (%s), got error: %s", rt.Type, formatRecordSetValue(rt, &rec), rec.ID, err))
+				return diags
+			}
+		}
+	}
+
+	for _, rec := range toCreate {
+		if _, err := records.Create(ctx, zone, &rec); err != nil {
+			addAPIError(&diags, "Client Error", fmt.Sprintf("Unable to create %s record %s, got error: %s", rt.Type, formatRecordSetValue(rt, &rec), err), err, nil)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readValues sets values from the live records, keeping the configured form
+// of values that only differ in notation
+func (data *DNSRecordSetResourceModel) readValues(ctx context.Context, rt *dnsRecordType, live []DNSRecord) diag.Diagnostics {
+	prior := make(map[string][]string)
+	if !data.Values.IsNull() && !data.Values.IsUnknown() {
+		var values []string
+		diags := data.Values.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return diags
+		}
+		for _, v := range values {
+			if rec, err := parseRecordSetValue(rt, v); err == nil {
+				key := recordSetValueKey(rt, &rec)
+				prior[key] = append(prior[key], v)
+			}
+		}
+	}
+
+	elems := make([]attr.Value, 0, len(live))
+	for _, rec := range live {
+		value := formatRecordSetValue(rt, &rec)
+		key := recordSetValueKey(rt, &rec)
+		if p := prior[key]; len(p) > 0 {
+			value = p[0]
+			prior[key] = p[1:]
+		}
+		elems = append(elems, types.StringValue(value))
+	}
+
+	values, diags := types.SetValue(types.StringType, elems)
+	data.Values = values
+	return diags
+}
+
+func (r *DNSRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	rt := dnsRecordTypeByName(data.Type.ValueString())
+	desired, diags := data.desiredRecords(ctx, rt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := r.client.Records(rt.Type).FindAllByName(ctx, zone, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s records, got error: %s", rt.Type, err))
+		return
+	}
+
+	// Refuse to take over records that are not part of the configuration
+	wanted := make(map[string]bool)
+	for _, rec := range desired {
+		wanted[recordSetValueKey(rt, &rec)] = true
+	}
+	var unmanaged []string
+	for _, rec := range live {
+		if !wanted[recordSetValueKey(rt, &rec)] {
+			unmanaged = append(unmanaged, formatRecordSetValue(rt, &rec))
+		}
+	}
+	if len(unmanaged) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("values"),
+			"Existing Records",
+			fmt.Sprintf("%s already has %s records that are not in values: %s. Add them to values, or import the record set with: terraform import <address> %s/%s/%s",
+				data.Name.ValueString(), rt.Type, strings.Join(unmanaged, ", "), zone, rt.Type, data.Name.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, zone, rt, desired, live)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(recordSetID(zone, rt, data.Name.ValueString()))
+	data.FQDN = types.StringValue(canonicalRecordName(zone, data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created DNS record set")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	rt := dnsRecordTypeByName(data.Type.ValueString())
+	if rt == nil {
+		resp.Diagnostics.AddError("Invalid Record Type", fmt.Sprintf("Unsupported record type %q", data.Type.ValueString()))
+		return
+	}
+
+	live, err := r.client.Records(rt.Type).FindAllByName(ctx, zone, data.Name.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s records, got error: %s", rt.Type, err))
+		return
+	}
+	if len(live) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.readValues(ctx, rt, live)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = normalizedRecordName(zone, data.Name, live[0].Name)
+	data.Type = types.StringValue(rt.Type)
+	data.FQDN = types.StringValue(canonicalRecordName(zone, data.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	rt := dnsRecordTypeByName(data.Type.ValueString())
+	desired, diags := data.desiredRecords(ctx, rt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := r.client.Records(rt.Type).FindAllByName(ctx, zone, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s records, got error: %s", rt.Type, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, zone, rt, desired, live)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only changes in the form of the name are updated in place, so the ID
+	// stays the same
+	data.ID = state.ID
+	data.FQDN = types.StringValue(canonicalRecordName(zone, data.Name.ValueString()))
+
+	tflog.Trace(ctx, "updated DNS record set")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSRecordSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	rt := dnsRecordTypeByName(data.Type.ValueString())
+	desired, diags := data.desiredRecords(ctx, rt)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := r.client.Records(rt.Type)
+	live, err := records.FindAllByName(ctx, zone, data.Name.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %s records, got error: %s", rt.Type, err))
+		return
+	}
+
+	// Only delete the records in state
+	managed := make(map[string]bool)
+	for _, rec := range desired {
+		managed[recordSetValueKey(rt, &rec)] = true
+	}
+	for _, rec := range live {
+		if !managed[recordSetValueKey(rt, &rec)] {
+			continue
+		}
+		if err := records.Delete(ctx, zone, rec.ID); err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s record %s, got error: %s", rt.Type, formatRecordSetValue(rt, &rec), err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted DNS record set")
+}
+
+func (r *DNSRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: zone/type/name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'zone/type/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	rt := dnsRecordTypeByName(parts[1])
+	if rt == nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unsupported record type %q, expected one of: %s", parts[1], strings.Join(dnsRecordTypeNames(), ", ")),
+		)
+		return
+	}
+
+	name := canonicalRecordName(parts[0], parts[2])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordSetID(parts[0], rt, name))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), rt.Type)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
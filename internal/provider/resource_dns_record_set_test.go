@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestRecordSetValues(t *testing.T) {
+	tests := []struct {
+		rt       *dnsRecordType
+		value    string
+		expected DNSRecord
+	}{
+		{dnsRecordTypeA, "192.0.2.1", DNSRecord{Destination: "192.0.2.1"}},
+		{dnsRecordTypeMX, "10 mail.example.com", DNSRecord{Priority: 10, Destination: "mail.example.com"}},
+		{dnsRecordTypeMX, "10\tmail.example.com", DNSRecord{Priority: 10, Destination: "mail.example.com"}},
+		{dnsRecordTypeSRV, "10 5 5060  sip.example.com", DNSRecord{Priority: 10, Weight: 5, Port: 5060, Destination: "sip.example.com"}},
+		{dnsRecordTypeCAA, "0 issue letsencrypt.org", DNSRecord{Flag: 0, Tag: "issue", Destination: "letsencrypt.org"}},
+		{dnsRecordTypeTXT, "v=spf1 include:_spf.example.com -all", DNSRecord{Destination: "v=spf1 include:_spf.example.com -all"}},
+		{dnsRecordTypeTXT, " a  b ", DNSRecord{Destination: "a  b"}},
+	}
+
+	for _, tt := range tests {
+		record, err := parseRecordSetValue(tt.rt, tt.value)
+		if err != nil {
+			t.Errorf("%s %q: unexpected error: %v", tt.rt.Type, tt.value, err)
+			continue
+		}
+		if record != tt.expected {
+			t.Errorf("%s %q: expected %+v, got %+v", tt.rt.Type, tt.value, tt.expected, record)
+		}
+		if formatted := formatRecordSetValue(tt.rt, &record); formatted != strings.Join(strings.Fields(tt.value), " ") && tt.rt != dnsRecordTypeTXT {
+			t.Errorf("%s %q: formatted as %q", tt.rt.Type, tt.value, formatted)
+		}
+	}
+
+	for _, invalid := range []string{"mail.example.com", "ten mail.example.com", "10"} {
+		if _, err := parseRecordSetValue(dnsRecordTypeMX, invalid); err == nil {
+			t.Errorf("MX %q: expected error", invalid)
+		}
+	}
+}
+
+func TestValidateRecordSetValue(t *testing.T) {
+	tests := []struct {
+		rt    *dnsRecordType
+		value string
+		valid bool
+	}{
+		{dnsRecordTypeMX, "10 mail.example.com", true},
+		{dnsRecordTypeCAA, "0 issue letsencrypt.org", true},
+		{dnsRecordTypeCAA, "0 issues letsencrypt.org", false},
+		{dnsRecordTypeSRV, "10 5 70000 sip.example.com", false},
+		{dnsRecordTypeA, "192.0.2.256", false},
+	}
+
+	for _, tt := range tests {
+		record, err := parseRecordSetValue(tt.rt, tt.value)
+		if err != nil {
+			t.Fatalf("%s %q: unexpected error: %v", tt.rt.Type, tt.value, err)
+		}
+		diags := validateRecordSetValue(context.Background(), tt.rt, tt.value, &record)
+		if diags.HasError() == tt.valid {
+			t.Errorf("%s %q: expected valid %t, got %v", tt.rt.Type, tt.value, tt.valid, diags)
+		}
+	}
+}
+
+func TestRecordSetValueKey(t *testing.T) {
+	a := DNSRecord{Priority: 10, Destination: "Mail.Example.com."}
+	b := DNSRecord{Priority: 10, Destination: "mail.example.com"}
+	if recordSetValueKey(dnsRecordTypeMX, &a) != recordSetValueKey(dnsRecordTypeMX, &b) {
+		t.Error("expected hostnames to be compared in canonical form")
+	}
+
+	c := DNSRecord{Destination: "2001:db8:0:0::1"}
+	d := DNSRecord{Destination: "2001:db8::1"}
+	if recordSetValueKey(dnsRecordTypeAAAA, &c) != recordSetValueKey(dnsRecordTypeAAAA, &d) {
+		t.Error("expected addresses to be compared in canonical form")
+	}
+
+	e := DNSRecord{Destination: "Hello"}
+	f := DNSRecord{Destination: "hello"}
+	if recordSetValueKey(dnsRecordTypeTXT, &e) == recordSetValueKey(dnsRecordTypeTXT, &f) {
+		t.Error("expected TXT values to be compared exactly")
+	}
+}
+
+func TestRecordSetReconcile_MockServer(t *testing.T) {
+	var deleted, created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "POST" && r.URL.Path == "/dns/example.com/a":
+			var record DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			created = append(created, record.Name+" "+record.Destination)
+			record.ID = "9"
+			json.NewEncoder(w).Encode([]DNSRecord{record})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	r := &DNSRecordSetResource{client: client}
+
+	live := []DNSRecord{
+		{ID: "1", Name: "www", Destination: "192.0.2.1"},
+		{ID: "2", Name: "www", Destination: "192.0.2.2"},
+		{ID: "3", Name: "www", Destination: "192.0.2.2"}, // duplicate
+	}
+	desired := []DNSRecord{
+		{Name: "www.example.com", Destination: "192.0.2.3"},
+		{Name: "www.example.com", Destination: "192.0.2.2"},
+	}
+
+	diags := r.reconcile(context.Background(), "example.com", dnsRecordTypeA, desired, live)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	sort.Strings(deleted)
+	if strings.Join(deleted, ",") != "/dns/example.com/a/1,/dns/example.com/a/3" {
+		t.Errorf("unexpected deletes: %v", deleted)
+	}
+	if strings.Join(created, ",") != "www.example.com 192.0.2.3" {
+		t.Errorf("unexpected creates: %v", created)
+	}
+
+	// Reordered values with the same content make no changes
+	deleted, created = nil, nil
+	live = []DNSRecord{
+		{ID: "2", Name: "www", Destination: "192.0.2.2"},
+		{ID: "9", Name: "www", Destination: "192.0.2.3"},
+	}
+	desired[0], desired[1] = desired[1], desired[0]
+	if diags := r.reconcile(context.Background(), "example.com", dnsRecordTypeA, desired, live); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(deleted) != 0 || len(created) != 0 {
+		t.Errorf("expected no changes, got deletes %v and creates %v", deleted, created)
+	}
+}
+
+func TestRecordSetConflicts(t *testing.T) {
+	live := []zoneRecord{
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "1", Name: "www", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeTXT, Record: DNSRecord{ID: "2", Name: "www", Destination: "v=spf1 -all"}},
+		{Type: dnsRecordTypeCNAME, Record: DNSRecord{ID: "3", Name: "blog", Destination: "example.org"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "4", Name: "api", Destination: "192.0.2.9"}},
+	}
+
+	tests := []struct {
+		name     string
+		rt       *dnsRecordType
+		setName  string
+		expected string // detail substring, "" for no conflicts
+	}{
+		{"CNAME alongside A and TXT", dnsRecordTypeCNAME, "www", "A record 1 (192.0.2.1), TXT record 2 (v=spf1 -all)"},
+		{"A alongside CNAME", dnsRecordTypeA, "blog.example.com", "CNAME record 3 (example.org)"},
+		{"TXT alongside CNAME", dnsRecordTypeTXT, "Blog.Example.com.", "CNAME record 3 (example.org)"},
+		{"existing records of the set are owned", dnsRecordTypeA, "api", ""},
+		{"existing CNAME of a CNAME set is owned", dnsRecordTypeCNAME, "blog", ""},
+		{"unused name", dnsRecordTypeCNAME, "shop", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts := recordSetConflicts("example.com", tt.rt, tt.setName, live)
+			if tt.expected == "" {
+				if len(conflicts) != 0 {
+					t.Errorf("expected no conflicts, got %+v", conflicts)
+				}
+				return
+			}
+			if len(conflicts) != 1 || conflicts[0].Warning || conflicts[0].Summary != "Conflicting DNS Records" {
+				t.Fatalf("expected one conflict error, got %+v", conflicts)
+			}
+			if !strings.Contains(conflicts[0].Detail, tt.expected) {
+				t.Errorf("expected detail containing %q, got %q", tt.expected, conflicts[0].Detail)
+			}
+		})
+	}
+}
+
+func TestRecordSetID(t *testing.T) {
+	for _, name := range []string{"www", "www.example.com", "WWW.example.com."} {
+		if got := recordSetID("example.com", dnsRecordTypeA, name); got != "example.com/A/www.example.com" {
+			t.Errorf("recordSetID(%q) = %q, expected example.com/A/www.example.com", name, got)
+		}
+	}
+}
@@ -35,7 +35,7 @@ func TestAccDNSARecordResource(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_recreate"},
+				ImportStateVerifyIgnore: []string{"force_recreate", "on_conflict"},
 			},
 			// Update testing
 			{
@@ -128,3 +128,37 @@ resource "zoneeu_dns_a_record" "test" {
 }
 `, domain, name, destination)
 }
+
+func TestAccDNSARecordResource_OnConflict(t *testing.T) {
+	domain := os.Getenv("ZONE_EU_TEST_DOMAIN")
+	if domain == "" {
+		t.Skip("ZONE_EU_TEST_DOMAIN must be set for acceptance tests")
+	}
+
+	resourceName := "zoneeu_dns_a_record.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSARecordResourceConfigOnConflict(domain, "test-conflict", "192.168.1.101", "replace_all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "on_conflict", "replace_all"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "192.168.1.101"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSARecordResourceConfigOnConflict(domain, name, destination, onConflict string) string {
+	return fmt.Sprintf(`
+resource "zoneeu_dns_a_record" "test" {
+  zone        = %[1]q
+  name        = %[2]q
+  destination = %[3]q
+  on_conflict = %[4]q
+}
+`, domain, name, destination, onConflict)
+}
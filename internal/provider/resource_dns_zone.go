@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &DNSZoneResource{}
+	_ resource.ResourceWithImportState = &DNSZoneResource{}
+)
+
+type DNSZoneResource struct {
+	client *Client
+}
+
+type DNSZoneResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Active      types.Bool   `tfsdk:"active"`
+	IPv6        types.Bool   `tfsdk:"ipv6"`
+	ResourceURL types.String `tfsdk:"resource_url"`
+}
+
+func NewDNSZoneResource() resource.Resource {
+	return &DNSZoneResource{}
+}
+
+func (r *DNSZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *DNSZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a DNS zone in Zone.EU. Note: This resource manages an existing zone - it does not create or delete zones.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the zone (same as name).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The DNS zone name (domain name, e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the zone is active. If not set, the current value is left unchanged.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6": schema.BoolAttribute{
+				Description: "Whether IPv6 records are allowed (IPv6 auto-records). If not set, the current value is left unchanged.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_url": schema.StringAttribute{
+				Description: "The API URL of the zone.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// update applies the configured zone settings. Settings that are not
+// configured are left unchanged.
+func (r *DNSZoneResource) update(data *DNSZoneResourceModel) (*DNSZone, error) {
+	update := &DNSZoneUpdate{}
+	if !data.Active.IsNull() && !data.Active.IsUnknown() {
+		active := data.Active.ValueBool()
+		update.Active = &active
+	}
+	if !data.IPv6.IsNull() && !data.IPv6.IsUnknown() {
+		ipv6 := data.IPv6.ValueBool()
+		update.IPv6 = &ipv6
+	}
+
+	if update.Active == nil && update.IPv6 == nil {
+		return r.client.GetDNSZone(data.Name.ValueString())
+	}
+	return r.client.UpdateDNSZone(data.Name.ValueString(), update)
+}
+
+func (data *DNSZoneResourceModel) fromZone(zone *DNSZone) {
+	data.ID = data.Name
+	data.Active = types.BoolValue(zone.Active)
+	data.IPv6 = types.BoolValue(zone.IPv6)
+	data.ResourceURL = types.StringValue(zone.ResourceURL)
+}
+
+func (r *DNSZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// First verify the zone exists
+	if _, err := r.client.GetDNSZone(data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading DNS Zone",
+			fmt.Sprintf("Could not read zone %s: %s. Note: This resource manages existing zones, it does not create new ones.", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	zone, err := r.update(&data)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating DNS Zone",
+			fmt.Sprintf("Could not update zone %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("active", "ipv6"),
+		)
+		return
+	}
+
+	data.fromZone(zone)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDNSZone(data.Name.ValueString())
+	if err != nil {
+		// Handle 404 - zone no longer exists or is not accessible
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading DNS Zone",
+			fmt.Sprintf("Could not read zone %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.fromZone(zone)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.update(&data)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating DNS Zone",
+			fmt.Sprintf("Could not update zone %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("active", "ipv6"),
+		)
+		return
+	}
+
+	data.fromZone(zone)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Zones cannot be deleted via API and deactivating the zone on destroy
+	// would take the domain offline, so the zone is just removed from state
+}
+
+func (r *DNSZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by zone name
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
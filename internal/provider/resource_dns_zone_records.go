@@ -0,0 +1,638 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &DNSZoneRecordsResource{}
+	_ resource.ResourceWithImportState    = &DNSZoneRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &DNSZoneRecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSZoneRecordsResource{}
+)
+
+func NewDNSZoneRecordsResource() resource.Resource {
+	return &DNSZoneRecordsResource{}
+}
+
+// DNSZoneRecordsResource manages the complete record set of a zone. Records
+// that exist in Zone.EU but are not in the configuration are deleted.
+type DNSZoneRecordsResource struct {
+	client *Client
+}
+
+type DNSZoneRecordsResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Zone    types.String `tfsdk:"zone"`
+	Records types.Set    `tfsdk:"records"`
+}
+
+func (r *DNSZoneRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_records"
+}
+
+// zoneRecordAttrTypes returns the attribute types of a records element: the
+// common type, name and destination attributes plus the type-specific
+// attributes of every record type.
+func zoneRecordAttrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"type":        types.StringType,
+		"name":        types.StringType,
+		"destination": types.StringType,
+	}
+	for _, rt := range dnsRecordTypes {
+		for _, f := range rt.Fields {
+			if f.intValue != nil {
+				attrTypes[f.Attribute] = types.Int64Type
+			} else {
+				attrTypes[f.Attribute] = types.StringType
+			}
+		}
+	}
+	return attrTypes
+}
+
+func (r *DNSZoneRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recordAttributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "The record type: " + strings.Join(dnsRecordTypeNames(), ", ") + ".",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dnsRecordTypeNames()...),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The hostname for the record (FQDN, e.g., www.example.com).",
+			Required:    true,
+		},
+		"destination": schema.StringAttribute{
+			Description: "The record value (address, hostname, text, URL, ...).",
+			Required:    true,
+		},
+	}
+	for _, rt := range dnsRecordTypes {
+		for _, f := range rt.Fields {
+			if _, ok := recordAttributes[f.Attribute]; ok {
+				continue
+			}
+			description := fmt.Sprintf("%s records only. %s", rt.Type, f.Schema.GetDescription())
+			if f.intValue != nil {
+				recordAttributes[f.Attribute] = schema.Int64Attribute{Description: description, Optional: true}
+			} else {
+				recordAttributes[f.Attribute] = schema.StringAttribute{Description: description, Optional: true}
+			}
+		}
+	}
+	// priority is shared by MX and SRV records
+	recordAttributes["priority"] = schema.Int64Attribute{
+		Description: "MX and SRV records only. The priority of the target host (lower values have higher priority).",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages all DNS records of a zone on Zone.EU. Records that exist in the zone but are not " +
+			"listed in `records` are deleted. Records that Zone.EU does not allow to be deleted (such as its default NS " +
+			"records) are never deleted: they may be listed in `records`, in which case they are not created again and are " +
+			"left in place on destroy, and are ignored otherwise. Do not combine this resource with the individual record resources for the same zone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource (same as zone).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "The DNS zone name (domain name, e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				Description: "The complete set of records in the zone.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSZoneRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSZoneRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var zone types.String
+	var records types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("records"), &records)...)
+	if resp.Diagnostics.HasError() || records.IsNull() || records.IsUnknown() {
+		return
+	}
+
+	// Record names by canonical name, for the CNAME checks below
+	namesKnown := !zone.IsUnknown() && !zone.IsNull()
+	typesByName := map[string][]string{}
+
+	for _, elem := range records.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+		attrs := obj.Attributes()
+		typeValue, ok := attrs["type"].(types.String)
+		if !ok || typeValue.IsUnknown() {
+			continue
+		}
+		rt := dnsRecordTypeByName(typeValue.ValueString())
+		if rt == nil {
+			continue // reported by the OneOf validator
+		}
+
+		recordName := "(unknown)"
+		if nameValue, ok := attrs["name"].(types.String); ok && !nameValue.IsUnknown() {
+			recordName = nameValue.ValueString()
+			if namesKnown {
+				name := canonicalRecordName(zone.ValueString(), recordName)
+				typesByName[name] = append(typesByName[name], rt.Type)
+			}
+		}
+
+		allowed := map[string]bool{"type": true, "name": true, "destination": true}
+		for _, f := range rt.Fields {
+			allowed[f.Attribute] = true
+			if attrs[f.Attribute].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("records"),
+					"Missing Record Attribute",
+					fmt.Sprintf("%s record %s requires the %q attribute.", rt.Type, recordName, f.Attribute),
+				)
+			}
+		}
+		for name, value := range attrs {
+			if !allowed[name] && !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("records"),
+					"Unsupported Record Attribute",
+					fmt.Sprintf("%s record %s does not support the %q attribute.", rt.Type, recordName, name),
+				)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(typesByName))
+	for name := range typesByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		recordTypes := typesByName[name]
+		cnames := 0
+		for _, t := range recordTypes {
+			if t == dnsRecordTypeCNAME.Type {
+				cnames++
+			}
+		}
+		switch {
+		case cnames == 0:
+		case isZoneApex(zone.ValueString(), name):
+			resp.Diagnostics.AddAttributeError(path.Root("records"), "Invalid CNAME Record", cnameAtApexDetail(zone.ValueString()))
+		case cnames > 1:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"Duplicate DNS Record",
+				fmt.Sprintf("%s has %d CNAME records. A name can only have one CNAME record.", name, cnames),
+			)
+		case len(recordTypes) > 1:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"Conflicting DNS Records",
+				fmt.Sprintf("%s has a CNAME record and %s records. A CNAME record cannot coexist with other records of the same name.",
+					name, strings.Join(otherTypes(recordTypes, dnsRecordTypeCNAME.Type), ", ")),
+			)
+		}
+	}
+}
+
+// ModifyPlan warns about the live records that creating the resource deletes.
+// Terraform only shows the configured records on create, so without the
+// warning those deletions would not be visible in the plan. After import or
+// the first apply they show up as changes to records instead.
+func (r *DNSZoneRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only creating the resource needs the check
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data DNSZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Zone.IsUnknown() {
+		return
+	}
+	if records, err := data.Records.ToTerraformValue(ctx); err != nil || !records.IsFullyKnown() {
+		return
+	}
+	zone := data.Zone.ValueString()
+
+	desired, diags := zoneRecordsFromSet(data.Records)
+	if diags.HasError() {
+		return // reported during apply
+	}
+
+	live, err := listZoneRecords(ctx, r.client, zone)
+	if err != nil {
+		if !IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Unable to Check Existing Records",
+				fmt.Sprintf("Could not list the records of zone %s, records that are not in the configuration will be deleted without being listed here: %s", zone, err),
+			)
+		}
+		return
+	}
+
+	_, toDelete := diffZoneRecords(zone, desired, live)
+	if len(toDelete) == 0 {
+		return
+	}
+	lines := make([]string, 0, len(toDelete))
+	for _, zr := range toDelete {
+		lines = append(lines, fmt.Sprintf("  - %s %s %s", zr.Type.Type, canonicalRecordName(zone, zr.Record.Name), formatRecordSetValue(zr.Type, &zr.Record)))
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("records"),
+		"Existing Records Will Be Deleted",
+		fmt.Sprintf("Zone %s has %d records that are not in records and will be deleted on apply:\n%s\n\n"+
+			"Add them to records to keep them, or import the zone first (terraform import with ID %s) to review them as deletions in the plan.",
+			zone, len(toDelete), strings.Join(lines, "\n"), zone),
+	)
+}
+
+// otherTypes returns the distinct record types in recordTypes except skip
+func otherTypes(recordTypes []string, skip string) []string {
+	var others []string
+	seen := map[string]bool{skip: true}
+	for _, t := range recordTypes {
+		if !seen[t] {
+			seen[t] = true
+			others = append(others, t)
+		}
+	}
+	return others
+}
+
+// zoneRecordFromObject converts a records element into its type and API form
+func zoneRecordFromObject(obj types.Object) (zoneRecord, error) {
+	attrs := obj.Attributes()
+	recordType := attrs["type"].(types.String).ValueString()
+	rt := dnsRecordTypeByName(recordType)
+	if rt == nil {
+		return zoneRecord{}, fmt.Errorf("unsupported record type %q", recordType)
+	}
+
+	record := DNSRecord{
+		Name:        attrs["name"].(types.String).ValueString(),
+		Destination: attrs["destination"].(types.String).ValueString(),
+	}
+	for _, f := range rt.Fields {
+		if f.intValue != nil {
+			*f.intValue(&record) = int(attrs[f.Attribute].(types.Int64).ValueInt64())
+		} else {
+			*f.stringValue(&record) = attrs[f.Attribute].(types.String).ValueString()
+		}
+	}
+	return zoneRecord{Type: rt, Record: record}, nil
+}
+
+// zoneRecordToObject converts a record into a records element. Attributes that
+// do not apply to the record type are null.
+func zoneRecordToObject(zr zoneRecord) (types.Object, diag.Diagnostics) {
+	attrTypes := zoneRecordAttrTypes()
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		if t == types.Int64Type {
+			attrs[name] = types.Int64Null()
+		} else {
+			attrs[name] = types.StringNull()
+		}
+	}
+
+	attrs["type"] = types.StringValue(zr.Type.Type)
+	attrs["name"] = types.StringValue(zr.Record.Name)
+	attrs["destination"] = types.StringValue(zr.Record.Destination)
+	for _, f := range zr.Type.Fields {
+		if f.intValue != nil {
+			attrs[f.Attribute] = types.Int64Value(int64(*f.intValue(&zr.Record)))
+		} else {
+			attrs[f.Attribute] = types.StringValue(*f.stringValue(&zr.Record))
+		}
+	}
+
+	return types.ObjectValue(attrTypes, attrs)
+}
+
+// zoneRecordAttributePaths maps the API fields of a record type to the
+// records attribute, since a set element has no path of its own
+func zoneRecordAttributePaths(rt *dnsRecordType) map[string]path.Path {
+	attributes := rt.attributePaths()
+	for field := range attributes {
+		attributes[field] = path.Root("records")
+	}
+	return attributes
+}
+
+// zoneRecordsFromSet converts the records attribute into API records
+func zoneRecordsFromSet(set types.Set) ([]zoneRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var records []zoneRecord
+	for _, elem := range set.Elements() {
+		zr, err := zoneRecordFromObject(elem.(types.Object))
+		if err != nil {
+			diags.AddAttributeError(path.Root("records"), "Invalid Record", err.Error())
+			continue
+		}
+		records = append(records, zr)
+	}
+	return records, diags
+}
+
+// isProtectedRecord reports whether Zone.EU does not allow the record to be
+// deleted, such as its default NS records
+func isProtectedRecord(zr zoneRecord) bool {
+	return zr.Record.Deletable != nil && !*zr.Record.Deletable
+}
+
+// diffZoneRecords compares the desired records with the live records of a
+// zone. Live records whose content matches a desired record are kept, the
+// remaining desired records are returned to be created and the remaining live
+// records to be deleted. Protected records satisfy a matching desired record
+// but are never deleted.
+func diffZoneRecords(zone string, desired, live []zoneRecord) (toCreate, toDelete []zoneRecord) {
+	// Prefer protected records when matching, as those stay in any case
+	byKey := make(map[string][]int)
+	for _, protected := range []bool{true, false} {
+		for i, zr := range live {
+			if isProtectedRecord(zr) == protected {
+				key := zr.Type.recordKey(zone, &zr.Record)
+				byKey[key] = append(byKey[key], i)
+			}
+		}
+	}
+
+	matched := make([]bool, len(live))
+	for _, zr := range desired {
+		key := zr.Type.recordKey(zone, &zr.Record)
+		if existing := byKey[key]; len(existing) > 0 {
+			matched[existing[0]] = true
+			byKey[key] = existing[1:]
+			continue
+		}
+		toCreate = append(toCreate, zr)
+	}
+
+	for i, zr := range live {
+		if !matched[i] && !isProtectedRecord(zr) {
+			toDelete = append(toDelete, zr)
+		}
+	}
+	return toCreate, toDelete
+}
+
+// apply makes the live zone match the desired records, see diffZoneRecords
+func (r *DNSZoneRecordsResource) apply(ctx context.Context, zone string, desired []zoneRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	live, err := listZoneRecords(ctx, r.client, zone)
+	if err != nil {
+		addAPIError(&diags, "Client Error", fmt.Sprintf("Unable to list records of zone %s, got error: %s", zone, err), err, nil)
+		return diags
+	}
+
+	toCreate, toDelete := diffZoneRecords(zone, desired, live)
+
+	// Delete first so that replaced records (e.g. an A record turned into a
+	// CNAME) do not cause a zone_conflict on create
+	for _, zr := range toDelete {
+		tflog.Info(ctx, "deleting DNS record not present in configuration", map[string]interface{}{
+			"zone":      zone,
+			"type":      zr.Type.Type,
+			"name":      zr.Record.Name,
+			"record_id": zr.Record.ID,
+		})
+		err := r.client.Records(zr.Type.Type).Delete(ctx, zone, zr.Record.ID)
+		if err != nil && !IsNotFound(err) {
+			addAPIError(&diags, "Client Error", fmt.Sprintf("Unable to delete %s record %s (%s), got error: %s", zr.Type.Type, zr.Record.Name, zr.Record.ID, err), err, nil)
+			return diags
+		}
+	}
+
+	for _, zr := range toCreate {
+		record := zr.Record
+		_, err := r.client.Records(zr.Type.Type).Create(ctx, zone, &record)
+		if err != nil {
+			addAPIError(&diags,
+				fmt.Sprintf("Error Creating %s Record %s", zr.Type.Type, zr.Record.Name),
+				fmt.Sprintf("Unable to create %s record %s, got error: %s", zr.Type.Type, zr.Record.Name, err),
+				err, zoneRecordAttributePaths(zr.Type),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *DNSZoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := zoneRecordsFromSet(data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data.Zone.ValueString(), desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Zone
+
+	tflog.Trace(ctx, "created DNS zone records")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.ID.ValueString()
+
+	live, err := listZoneRecords(ctx, r.client, zone)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to list records of zone %s, got error: %s", zone, err), err, nil)
+		return
+	}
+
+	// Keep the name as written in the configuration when the API returns the
+	// same record in a different form (short name vs FQDN). Other records use
+	// the canonical FQDN. Protected records are only kept when they are in the
+	// configuration, so listing them does not cause a diff.
+	previous := make(map[string][]zoneRecord)
+	if !data.Records.IsNull() && !data.Records.IsUnknown() {
+		prior, diags := zoneRecordsFromSet(data.Records)
+		resp.Diagnostics.Append(diags...)
+		for _, zr := range prior {
+			key := zr.Type.recordKey(zone, &zr.Record)
+			previous[key] = append(previous[key], zr)
+		}
+	}
+
+	elems := make([]attr.Value, 0, len(live))
+	for _, zr := range live {
+		key := zr.Type.recordKey(zone, &zr.Record)
+		if prior := previous[key]; len(prior) > 0 {
+			zr.Record.Name = prior[0].Record.Name
+			previous[key] = prior[1:]
+		} else if isProtectedRecord(zr) {
+			continue
+		} else {
+			zr.Record.Name = canonicalRecordName(zone, zr.Record.Name)
+		}
+		obj, diags := zoneRecordToObject(zr)
+		resp.Diagnostics.Append(diags...)
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := types.SetValue(types.ObjectType{AttrTypes: zoneRecordAttrTypes()}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Zone = types.StringValue(zone)
+	data.Records = records
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := zoneRecordsFromSet(data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data.Zone.ValueString(), desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated DNS zone records")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := data.Zone.ValueString()
+	managed, diags := zoneRecordsFromSet(data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := listZoneRecords(ctx, r.client, zone)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to list records of zone %s, got error: %s", zone, err), err, nil)
+		return
+	}
+	liveByKey := make(map[string][]zoneRecord)
+	for _, zr := range live {
+		// Protected records in the configuration are left in place
+		if isProtectedRecord(zr) {
+			continue
+		}
+		key := zr.Type.recordKey(zone, &zr.Record)
+		liveByKey[key] = append(liveByKey[key], zr)
+	}
+
+	// Only delete the records in state, not ones added since the last refresh
+	for _, zr := range managed {
+		key := zr.Type.recordKey(zone, &zr.Record)
+		matches := liveByKey[key]
+		if len(matches) == 0 {
+			continue
+		}
+		liveByKey[key] = matches[1:]
+
+		err := r.client.Records(zr.Type.Type).Delete(ctx, zone, matches[0].Record.ID)
+		if err != nil && !IsNotFound(err) {
+			addAPIError(&resp.Diagnostics, "Client Error", fmt.Sprintf("Unable to delete %s record %s, got error: %s", zr.Type.Type, zr.Record.Name, err), err, nil)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted DNS zone records")
+}
+
+func (r *DNSZoneRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by zone name
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestZoneRecordObjectRoundTrip(t *testing.T) {
+	original := zoneRecord{
+		Type: dnsRecordTypeSRV,
+		Record: DNSRecord{
+			Name:        "_sip._tcp.example.com",
+			Destination: "sip.example.com",
+			Priority:    10,
+			Weight:      20,
+			Port:        5060,
+		},
+	}
+
+	obj, diags := zoneRecordToObject(original)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !obj.Attributes()["tag"].IsNull() {
+		t.Error("expected attributes of other record types to be null")
+	}
+
+	converted, err := zoneRecordFromObject(obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if converted.Type != dnsRecordTypeSRV {
+		t.Errorf("expected SRV record type, got %s", converted.Type.Type)
+	}
+	if converted.Record != original.Record {
+		t.Errorf("expected %+v, got %+v", original.Record, converted.Record)
+	}
+}
+
+func TestRecordKey(t *testing.T) {
+	short := DNSRecord{Name: "mail", Destination: "mx.example.com", Priority: 10}
+	fqdn := DNSRecord{Name: "mail.example.com", Destination: "mx.example.com", Priority: 10}
+	other := DNSRecord{Name: "mail.example.com", Destination: "mx.example.com", Priority: 20}
+
+	if dnsRecordTypeMX.recordKey("example.com", &short) != dnsRecordTypeMX.recordKey("example.com", &fqdn) {
+		t.Error("expected short and FQDN names to produce the same key")
+	}
+	if dnsRecordTypeMX.recordKey("example.com", &fqdn) == dnsRecordTypeMX.recordKey("example.com", &other) {
+		t.Error("expected different priorities to produce different keys")
+	}
+}
+
+func TestDiffZoneRecords(t *testing.T) {
+	protected := false
+	live := []zoneRecord{
+		{Type: dnsRecordTypeNS, Record: DNSRecord{ID: "1", Name: "example.com", Destination: "ns1.zone.ee", Deletable: &protected}},
+		{Type: dnsRecordTypeNS, Record: DNSRecord{ID: "2", Name: "example.com", Destination: "ns2.zone.ee", Deletable: &protected}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "3", Name: "www.example.com", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{ID: "4", Name: "old.example.com", Destination: "192.0.2.2"}},
+	}
+	desired := []zoneRecord{
+		{Type: dnsRecordTypeNS, Record: DNSRecord{Name: "example.com", Destination: "ns1.zone.ee"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{Name: "www", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{Name: "new", Destination: "192.0.2.3"}},
+	}
+
+	toCreate, toDelete := diffZoneRecords("example.com", desired, live)
+
+	if len(toCreate) != 1 || toCreate[0].Record.Name != "new" {
+		t.Errorf("expected only the new A record to be created, got %+v", toCreate)
+	}
+	// The unlisted protected NS record stays
+	if len(toDelete) != 1 || toDelete[0].Record.ID != "4" {
+		t.Errorf("expected only record 4 to be deleted, got %+v", toDelete)
+	}
+}
+
+func TestZoneRecordAttributePaths(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &APIError{StatusCode: 422, FieldErrors: map[string]string{"destination": "invalid host", "priority": "out of range"}}
+	addAPIError(&diags, "Error Creating MX Record mail", "detail", err, zoneRecordAttributePaths(dnsRecordTypeMX))
+
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	for _, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root("records")) {
+			t.Errorf("expected the error on records, got %v", d)
+		}
+	}
+}
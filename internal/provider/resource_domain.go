@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -147,9 +146,10 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	domain, err = r.client.UpdateDomain(data.Name.ValueString(), update)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Updating Domain",
 			fmt.Sprintf("Could not update domain %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("autorenew", "dnssec", "nameservers_custom"),
 		)
 		return
 	}
@@ -160,9 +160,10 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	_, err = r.client.UpdateDomainPreferences(data.Name.ValueString(), prefs)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Updating Domain Preferences",
 			fmt.Sprintf("Could not update domain preferences for %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("renewal_notifications"),
 		)
 		return
 	}
@@ -195,7 +196,7 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	domain, err := r.client.GetDomain(data.Name.ValueString())
 	if err != nil {
 		// Handle 404 - domain no longer exists or is not accessible
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -253,9 +254,10 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	domain, err := r.client.UpdateDomain(data.Name.ValueString(), update)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Updating Domain",
 			fmt.Sprintf("Could not update domain %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("autorenew", "dnssec", "nameservers_custom"),
 		)
 		return
 	}
@@ -266,9 +268,10 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	_, err = r.client.UpdateDomainPreferences(data.Name.ValueString(), prefs)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Updating Domain Preferences",
 			fmt.Sprintf("Could not update domain preferences for %s: %s", data.Name.ValueString(), err),
+			err, rootAttributes("renewal_notifications"),
 		)
 		return
 	}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &DomainContactResource{}
+	_ resource.ResourceWithImportState    = &DomainContactResource{}
+	_ resource.ResourceWithValidateConfig = &DomainContactResource{}
+)
+
+// domainContactRoles are the values accepted by the role attribute
+var domainContactRoles = []string{"registrant", "admin", "tech"}
+
+// domainContactField describes a string attribute of a domain contact. The
+// resource and the data source build their schemas from this list.
+type domainContactField struct {
+	Attribute   string
+	Description string
+	Sensitive   bool
+	Validators  []validator.String
+	value       func(*DomainContact) *string
+}
+
+var domainContactFields = []domainContactField{
+	{Attribute: "type", Description: "The contact type as used by the registry.", value: func(c *DomainContact) *string { return &c.Type }},
+	{Attribute: "first_name", Description: "First name.", value: func(c *DomainContact) *string { return &c.FirstName }},
+	{Attribute: "last_name", Description: "Last name.", value: func(c *DomainContact) *string { return &c.LastName }},
+	{Attribute: "name", Description: "Full name.", value: func(c *DomainContact) *string { return &c.Name }},
+	{Attribute: "organization", Description: "Organization.", value: func(c *DomainContact) *string { return &c.Organization }},
+	{Attribute: "email", Description: "E-mail address.", value: func(c *DomainContact) *string { return &c.Email }},
+	{Attribute: "voice", Description: "Phone number.", value: func(c *DomainContact) *string { return &c.Voice }},
+	{Attribute: "fax", Description: "Fax number.", value: func(c *DomainContact) *string { return &c.Fax }},
+	{Attribute: "country", Description: "Country code.", value: func(c *DomainContact) *string { return &c.Country }},
+	{Attribute: "state", Description: "State or province.", value: func(c *DomainContact) *string { return &c.State }},
+	{Attribute: "city", Description: "City.", value: func(c *DomainContact) *string { return &c.City }},
+	{Attribute: "street", Description: "Street address.", value: func(c *DomainContact) *string { return &c.Street }},
+	{Attribute: "postalcode", Description: "Postal code.", value: func(c *DomainContact) *string { return &c.PostalCode }},
+	{Attribute: "ext_language", Description: "Language.", value: func(c *DomainContact) *string { return &c.ExtLanguage }},
+	{Attribute: "ext_ident", Description: "Identification number (personal or company registration number, or birthday).", Sensitive: true, value: func(c *DomainContact) *string { return &c.ExtIdent }},
+	{
+		Attribute:   "ext_ident_type",
+		Description: "Identification type: private_number, company_number or birthday.",
+		Validators:  []validator.String{stringvalidator.OneOf("private_number", "company_number", "birthday")},
+		value:       func(c *DomainContact) *string { return &c.ExtIdentType },
+	},
+	{Attribute: "ext_ident_cc", Description: "Country code of the identification.", value: func(c *DomainContact) *string { return &c.ExtIdentCC }},
+	{Attribute: "ext_vatnr", Description: "VAT number.", value: func(c *DomainContact) *string { return &c.ExtVATNr }},
+	{Attribute: "ext_department", Description: "Department.", value: func(c *DomainContact) *string { return &c.ExtDepartment }},
+	{Attribute: "ext_passport", Description: "Passport number.", Sensitive: true, value: func(c *DomainContact) *string { return &c.ExtPassport }},
+	{Attribute: "ext_legal_form", Description: "Legal form.", value: func(c *DomainContact) *string { return &c.ExtLegalForm }},
+	{Attribute: "registry_handle", Description: "The contact's handle (ID) at the domain registry.", value: func(c *DomainContact) *string { return &c.RegistryHandle }},
+}
+
+// setDomainContactState writes the role, identificator and contact fields to
+// state. Used by both the resource and the data source.
+func setDomainContactState(ctx context.Context, state *tfsdk.State, contact *DomainContact) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("role"), contact.Role)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("identificator"), strconv.Itoa(contact.Identificator))...)
+	for _, f := range domainContactFields {
+		diags.Append(state.SetAttribute(ctx, path.Root(f.Attribute), *f.value(contact))...)
+	}
+
+	return diags
+}
+
+// DomainContactResource manages a registrant, admin or tech contact of a
+// domain
+type DomainContactResource struct {
+	client *Client
+}
+
+func NewDomainContactResource() resource.Resource {
+	return &DomainContactResource{}
+}
+
+func (r *DomainContactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_contact"
+}
+
+func (r *DomainContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The identifier for this resource in format 'domain/identificator'.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"domain": schema.StringAttribute{
+			Description: "The domain name this contact belongs to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"identificator": schema.StringAttribute{
+			Description: "The ID of the contact in Zone.EU.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Description: "The contact role: " + strings.Join(domainContactRoles, ", ") + ".",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(domainContactRoles...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"use_default": schema.BoolAttribute{
+			Description: "If true, the account's default tech contact is added to the domain instead of a contact built from the attributes below. Only valid for the tech role. Default: false.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	for _, f := range domainContactFields {
+		attributes[f.Attribute] = schema.StringAttribute{
+			Description: f.Description + " Keeps the current value if not set.",
+			Optional:    true,
+			Computed:    true,
+			Sensitive:   f.Sensitive,
+			Validators:  f.Validators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a registrant, admin or tech contact of a domain in Zone.EU. " +
+			"Some registries charge for contact changes, which is not possible over the API; such changes fail with a payment required error and must be made in the Zone.EU web interface.",
+		Attributes: attributes,
+	}
+}
+
+func (r *DomainContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DomainContactResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var useDefault types.Bool
+	var role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("use_default"), &useDefault)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() || !useDefault.ValueBool() {
+		return
+	}
+
+	if !role.IsUnknown() && role.ValueString() != "tech" {
+		resp.Diagnostics.AddAttributeError(path.Root("use_default"), "Invalid Attribute Combination", "use_default can only be set for tech contacts.")
+	}
+	for _, f := range domainContactFields {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(f.Attribute), &v)...)
+		if !v.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(f.Attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s cannot be set together with use_default, the default tech contact is used as is.", f.Attribute),
+			)
+		}
+	}
+}
+
+// getContact builds the API payload from the role and contact fields in a
+// plan
+func (r *DomainContactResource) getContact(ctx context.Context, src attributeGetter) (*DomainContact, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	contact := &DomainContact{}
+
+	var role types.String
+	diags.Append(src.GetAttribute(ctx, path.Root("role"), &role)...)
+	contact.Role = role.ValueString()
+
+	for _, f := range domainContactFields {
+		var v types.String
+		diags.Append(src.GetAttribute(ctx, path.Root(f.Attribute), &v)...)
+		*f.value(contact) = v.ValueString()
+	}
+
+	return contact, diags
+}
+
+// contactErrorDetail adds a hint to errors for contact changes the API cannot
+// make
+func contactErrorDetail(detail string, err error) string {
+	if IsPaymentRequired(err) {
+		return detail + ". This contact change requires payment, which is not possible over the API; make it in the Zone.EU web interface."
+	}
+	return detail
+}
+
+// contactAttributes maps API field names to attribute paths for 422 errors
+func contactAttributes() map[string]path.Path {
+	names := []string{"role"}
+	for _, f := range domainContactFields {
+		names = append(names, f.Attribute)
+	}
+	return rootAttributes(names...)
+}
+
+func (r *DomainContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var domain types.String
+	var useDefault types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("use_default"), &useDefault)...)
+	contact, diags := r.getContact(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created *DomainContact
+	var err error
+	if useDefault.ValueBool() {
+		created, err = r.client.CreateDefaultTechContact(domain.ValueString())
+	} else {
+		created, err = r.client.CreateDomainContact(domain.ValueString(), contact)
+	}
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Creating Domain Contact",
+			contactErrorDetail(fmt.Sprintf("Could not create %s contact for domain %s: %s", contact.Role, domain.ValueString(), err), err),
+			err, contactAttributes(),
+		)
+		return
+	}
+
+	id := strconv.Itoa(created.Identificator)
+	tflog.Trace(ctx, "created domain contact", map[string]interface{}{
+		"domain":        domain.ValueString(),
+		"identificator": id,
+	})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", domain.ValueString(), id))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("use_default"), useDefault)...)
+	resp.Diagnostics.Append(setDomainContactState(ctx, &resp.State, created)...)
+}
+
+func (r *DomainContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var domain, id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("identificator"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contact, err := r.client.GetDomainContact(domain.ValueString(), id.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Contact",
+			fmt.Sprintf("Could not read contact %s for domain %s: %s", id.ValueString(), domain.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setDomainContactState(ctx, &resp.State, contact)...)
+}
+
+func (r *DomainContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var domain, id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("identificator"), &id)...)
+	contact, diags := r.getContact(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateDomainContact(domain.ValueString(), id.ValueString(), contact)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating Domain Contact",
+			contactErrorDetail(fmt.Sprintf("Could not update contact %s for domain %s: %s", id.ValueString(), domain.ValueString(), err), err),
+			err, contactAttributes(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "updated domain contact")
+
+	var useDefault types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("use_default"), &useDefault)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("use_default"), useDefault)...)
+	resp.Diagnostics.Append(setDomainContactState(ctx, &resp.State, updated)...)
+}
+
+func (r *DomainContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var domain, id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("identificator"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomainContact(domain.ValueString(), id.ValueString())
+	switch {
+	case err == nil, IsNotFound(err):
+	case IsPaymentRequired(err) || IsNotSupported(err):
+		// Like zoneeu_domain, the contact is only removed from Terraform
+		// management if Zone.EU cannot delete it
+		resp.Diagnostics.AddWarning(
+			"Domain Contact Not Deleted",
+			fmt.Sprintf("Contact %s of domain %s was removed from Terraform state, but Zone.EU did not delete it: %s", id.ValueString(), domain.ValueString(), err),
+		)
+	default:
+		resp.Diagnostics.AddError(
+			"Error Deleting Domain Contact",
+			fmt.Sprintf("Could not delete contact %s for domain %s: %s", id.ValueString(), domain.ValueString(), err),
+		)
+	}
+}
+
+func (r *DomainContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: domain/identificator
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'domain/identificator', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identificator"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
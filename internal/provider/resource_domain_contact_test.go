@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDomainContactFields(t *testing.T) {
+	// Every field must map to its own DomainContact field with the same JSON name
+	var contact DomainContact
+	for _, f := range domainContactFields {
+		*f.value(&contact) = f.Attribute
+	}
+
+	body, err := json.Marshal(contact)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, f := range domainContactFields {
+		if fields[f.Attribute] != f.Attribute {
+			t.Errorf("attribute %s maps to the wrong API field", f.Attribute)
+		}
+	}
+}
+
+func TestDomainContactSchema(t *testing.T) {
+	ctx := context.Background()
+
+	resourceResp := &resource.SchemaResponse{}
+	NewDomainContactResource().Schema(ctx, resource.SchemaRequest{}, resourceResp)
+	if diags := resourceResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("invalid resource schema: %v", diags)
+	}
+
+	dataSourceResp := &datasource.SchemaResponse{}
+	NewDomainContactDataSource().Schema(ctx, datasource.SchemaRequest{}, dataSourceResp)
+	if diags := dataSourceResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("invalid data source schema: %v", diags)
+	}
+
+	for _, f := range domainContactFields {
+		if _, ok := resourceResp.Schema.Attributes[f.Attribute]; !ok {
+			t.Errorf("resource: missing attribute %s", f.Attribute)
+		}
+		if _, ok := dataSourceResp.Schema.Attributes[f.Attribute]; !ok {
+			t.Errorf("data source: missing attribute %s", f.Attribute)
+		}
+	}
+}
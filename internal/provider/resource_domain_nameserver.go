@@ -131,9 +131,10 @@ func (r *DomainNameserverResource) Create(ctx context.Context, req resource.Crea
 	// Create/replace all nameservers
 	_, err = r.client.CreateDomainNameservers(data.Domain.ValueString(), nameservers)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Creating Domain Nameserver",
 			fmt.Sprintf("Could not create nameserver for domain %s: %s", data.Domain.ValueString(), err),
+			err, rootAttributes("hostname", "ip"),
 		)
 		return
 	}
@@ -155,7 +156,7 @@ func (r *DomainNameserverResource) Read(ctx context.Context, req resource.ReadRe
 	ns, err := r.client.GetDomainNameserver(data.Domain.ValueString(), data.Hostname.ValueString())
 	if err != nil {
 		// If not found, remove from state
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -199,9 +200,10 @@ func (r *DomainNameserverResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateDomainNameserver(data.Domain.ValueString(), data.Hostname.ValueString(), ns)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(&resp.Diagnostics,
 			"Error Updating Domain Nameserver",
 			fmt.Sprintf("Could not update nameserver %s for domain %s: %s", data.Hostname.ValueString(), data.Domain.ValueString(), err),
+			err, rootAttributes("hostname", "ip"),
 		)
 		return
 	}
@@ -222,7 +224,7 @@ func (r *DomainNameserverResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteDomainNameserver(data.Domain.ValueString(), data.Hostname.ValueString())
 	if err != nil {
 		// Ignore 404 errors on delete
-		if !strings.Contains(err.Error(), "404") && !strings.Contains(err.Error(), "not found") {
+		if !IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Error Deleting Domain Nameserver",
 				fmt.Sprintf("Could not delete nameserver %s for domain %s: %s", data.Hostname.ValueString(), data.Domain.ValueString(), err),
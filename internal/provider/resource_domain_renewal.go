@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainRenewalResource{}
+
+// DomainRenewalResource places a renew or reactivate order for a domain when
+// it is created. It is action-style: the order is placed once, and changing
+// any argument places a new order.
+type DomainRenewalResource struct {
+	client *Client
+}
+
+type DomainRenewalResourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Domain            types.String  `tfsdk:"domain"`
+	Period            types.Int64   `tfsdk:"period"`
+	Triggers          types.Map     `tfsdk:"triggers"`
+	Action            types.String  `tfsdk:"action"`
+	ExpireNew         types.String  `tfsdk:"expire_new"`
+	TotalPriceWithTax types.Float64 `tfsdk:"total_price_with_tax"`
+	Order             types.Object  `tfsdk:"order"`
+}
+
+// orderAttrTypes are the attribute types of the order attribute
+var orderAttrTypes = map[string]attr.Type{
+	"identificator":    types.StringType,
+	"errors":           types.StringType,
+	"vat_rate":         types.StringType,
+	"datetime_ordered": types.StringType,
+	"resource_url":     types.StringType,
+}
+
+// orderObject converts an API order into the order attribute value
+func orderObject(order *Order) types.Object {
+	errors := types.StringNull()
+	if failed := order.FailedRows(); failed != "" {
+		errors = types.StringValue(failed)
+	}
+	return types.ObjectValueMust(orderAttrTypes, map[string]attr.Value{
+		"identificator":    types.StringValue(order.Identificator),
+		"errors":           errors,
+		"vat_rate":         types.StringValue(order.VATRate),
+		"datetime_ordered": types.StringValue(order.DatetimeOrdered),
+		"resource_url":     types.StringValue(order.ResourceURL),
+	})
+}
+
+func NewDomainRenewalResource() resource.Resource {
+	return &DomainRenewalResource{}
+}
+
+func (r *DomainRenewalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_renewal"
+}
+
+func (r *DomainRenewalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Places a paid order to renew or reactivate a domain in Zone.EU (POST /order/domain/renew). " +
+			"The order is placed when the resource is created; changing any argument places a new order. " +
+			"Destroying the resource only removes it from state, it does not cancel the order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The order ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The domain name to renew or reactivate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"period": schema.Int64Attribute{
+				Description: "The renewal period, one of the periods Zone.EU offers for the domain (usually years).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that place a new order when changed, e.g. the domain's expiry date.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "The action that was ordered: renew, reactivate_redeem or reactivate_grace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_new": schema.StringAttribute{
+				Description: "The expiry date of the domain after the renewal, as quoted by Zone.EU when the order was placed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_price_with_tax": schema.Float64Attribute{
+				Description: "The price of the renewal including tax, as quoted by Zone.EU when the order was placed.",
+				Computed:    true,
+			},
+			"order": schema.SingleNestedAttribute{
+				Description: "The order placed in Zone.EU.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"identificator": schema.StringAttribute{
+						Description: "The order ID.",
+						Computed:    true,
+					},
+					"errors": schema.StringAttribute{
+						Description: "The number of failed order rows, null when none failed.",
+						Computed:    true,
+					},
+					"vat_rate": schema.StringAttribute{
+						Description: "The VAT rate of the order.",
+						Computed:    true,
+					},
+					"datetime_ordered": schema.StringAttribute{
+						Description: "When the order was placed (ISO 8601).",
+						Computed:    true,
+					},
+					"resource_url": schema.StringAttribute{
+						Description: "The API URL of the order.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainRenewalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// findRenewalPeriod returns the renewal option and period matching period,
+// and the periods that are available
+func findRenewalPeriod(options *DomainOptions, period int) (*DomainRenewalOption, *DomainRenewalPeriod, []int) {
+	var available []int
+	for i := range options.RenewalOptions {
+		option := &options.RenewalOptions[i]
+		for j := range option.ActionPeriods {
+			if option.ActionPeriods[j].Period == period {
+				return option, &option.ActionPeriods[j], nil
+			}
+			available = append(available, option.ActionPeriods[j].Period)
+		}
+	}
+	sort.Ints(available)
+	return nil, nil, available
+}
+
+func (r *DomainRenewalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	period := int(data.Period.ValueInt64())
+
+	// Check the period against the renewal options first, so an invalid
+	// period fails with the valid choices instead of a bare 422
+	options, err := r.client.GetDomainOptions(domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Options",
+			fmt.Sprintf("Could not read renewal options for domain %s: %s", domain, err),
+		)
+		return
+	}
+	option, quote, available := findRenewalPeriod(options, period)
+	if option == nil {
+		detail := fmt.Sprintf("Domain %s cannot be renewed or reactivated right now.", domain)
+		if len(available) > 0 {
+			periods := make([]string, len(available))
+			for i, p := range available {
+				periods[i] = fmt.Sprint(p)
+			}
+			detail = fmt.Sprintf("Domain %s cannot be renewed for %d; available periods: %s.", domain, period, strings.Join(periods, ", "))
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("period"), "Invalid Renewal Period", detail)
+		return
+	}
+
+	order, err := r.client.RenewDomain(domain, period)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Renewing Domain",
+			fmt.Sprintf("Could not place %s order for domain %s: %s", option.Action, domain, err),
+			err, rootAttributes("domain", "period"),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "placed domain renewal order", map[string]interface{}{
+		"domain": domain,
+		"period": period,
+		"action": option.Action,
+		"order":  order.Identificator,
+	})
+
+	data.ID = types.StringValue(order.Identificator)
+	data.Action = types.StringValue(option.Action)
+	data.ExpireNew = types.StringValue(quote.ExpireNew)
+	data.TotalPriceWithTax = types.Float64Value(quote.TotalPriceWithTax)
+	data.Order = orderObject(order)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The order exists, so it is kept in state; the error taints the
+	// resource so the next apply places a new order
+	if failed := order.FailedRows(); failed != "" {
+		resp.Diagnostics.AddError(
+			"Domain Renewal Order Failed",
+			fmt.Sprintf("Order %s for domain %s was placed, but %s order row(s) failed. Check the order in the Zone.EU web interface.", order.Identificator, domain, failed),
+		)
+	}
+}
+
+func (r *DomainRenewalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := r.client.GetDomainOrder(data.ID.ValueString())
+	if err != nil {
+		// Keep the resource if the order is gone: removing it from state
+		// would place a new, paid order on the next apply
+		if IsNotFound(err) {
+			tflog.Warn(ctx, "domain renewal order not found, keeping state", map[string]interface{}{
+				"order": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Domain Order",
+			fmt.Sprintf("Could not read order %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.Order = orderObject(order)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRenewalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires replacement, so there is nothing to update
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRenewalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Orders are not cancelled - the resource is just removed from state
+}
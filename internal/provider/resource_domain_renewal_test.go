@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderFailedRows(t *testing.T) {
+	tests := map[string]string{
+		`null`: "",
+		`"0"`:  "",
+		`0`:    "",
+		`""`:   "",
+		`"2"`:  "2",
+		`3`:    "3",
+	}
+	for input, expected := range tests {
+		order := Order{Errors: json.RawMessage(input)}
+		if got := order.FailedRows(); got != expected {
+			t.Errorf("%s: expected %q, got %q", input, expected, got)
+		}
+	}
+
+	if got := (&Order{}).FailedRows(); got != "" {
+		t.Errorf("missing errors: expected empty string, got %q", got)
+	}
+}
+
+func TestFindRenewalPeriod(t *testing.T) {
+	options := &DomainOptions{RenewalOptions: []DomainRenewalOption{
+		{Action: "reactivate_grace", ActionPeriods: []DomainRenewalPeriod{{Period: 2}, {Period: 1, TotalPriceWithTax: 15}}},
+	}}
+
+	option, quote, _ := findRenewalPeriod(options, 1)
+	if option == nil || option.Action != "reactivate_grace" || quote.TotalPriceWithTax != 15 {
+		t.Errorf("unexpected result: %+v %+v", option, quote)
+	}
+
+	option, _, available := findRenewalPeriod(options, 5)
+	if option != nil {
+		t.Errorf("expected no option, got %+v", option)
+	}
+	if !reflect.DeepEqual(available, []int{1, 2}) {
+		t.Errorf("expected available periods [1 2], got %v", available)
+	}
+}
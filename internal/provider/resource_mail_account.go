@@ -0,0 +1,454 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/idna"
+)
+
+var (
+	_ resource.Resource                   = &MailAccountResource{}
+	_ resource.ResourceWithImportState    = &MailAccountResource{}
+	_ resource.ResourceWithValidateConfig = &MailAccountResource{}
+)
+
+// mailSpamlevels are the accepted spamfilter levels of a mail account
+var mailSpamlevels = []string{"none", "low", "medium", "high"}
+
+func NewMailAccountResource() resource.Resource {
+	return &MailAccountResource{}
+}
+
+// MailAccountResource manages an e-mail account of a webhosting service
+type MailAccountResource struct {
+	client *Client
+}
+
+type MailAccountResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Service          types.String `tfsdk:"service"`
+	Address          types.String `tfsdk:"address"`
+	Password         types.String `tfsdk:"password"`
+	Comment          types.String `tfsdk:"comment"`
+	FwdAddresses     types.Set    `tfsdk:"fwd_addresses"`
+	Spamlevel        types.String `tfsdk:"spamlevel"`
+	TwoFactorAuth    types.Bool   `tfsdk:"two_factor_auth"`
+	Autoreply        types.Bool   `tfsdk:"autoreply"`
+	DiskSize         types.Int64  `tfsdk:"disk_size"`
+	DiskSizeHuman    types.String `tfsdk:"disk_size_human"`
+	DiskUsage        types.Int64  `tfsdk:"disk_usage"`
+	DiskUsageHuman   types.String `tfsdk:"disk_usage_human"`
+	DiskUsageUpdated types.String `tfsdk:"disk_usage_updated"`
+	Addresses        types.List   `tfsdk:"addresses"`
+}
+
+func (r *MailAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_account"
+}
+
+func (r *MailAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an e-mail account of a Zone.EU webhosting service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/address'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the account, 10-64 characters. Zone.EU never returns the password, so changes made outside Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(10, 64),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment for the account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fwd_addresses": schema.SetAttribute{
+				Description: "Addresses that incoming mail is forwarded to.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"spamlevel": schema.StringAttribute{
+				Description: "The spamfilter level: " + strings.Join(mailSpamlevels, ", ") + ".",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(mailSpamlevels...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"two_factor_auth": schema.BoolAttribute{
+				Description: "Whether two-factor authentication is enabled. It can only be enabled by the mailbox owner, so it can only be set to false here; leave it unset to keep the owner's choice.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"autoreply": schema.BoolAttribute{
+				Description: "Whether an autoreply is enabled for the account.",
+				Computed:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: "The mailbox size in bytes.",
+				Computed:    true,
+			},
+			"disk_size_human": schema.StringAttribute{
+				Description: "The mailbox size in human readable form.",
+				Computed:    true,
+			},
+			"disk_usage": schema.Int64Attribute{
+				Description: "The mailbox usage in bytes.",
+				Computed:    true,
+			},
+			"disk_usage_human": schema.StringAttribute{
+				Description: "The mailbox usage in human readable form.",
+				Computed:    true,
+			},
+			"disk_usage_updated": schema.StringAttribute{
+				Description: "When the disk usage was last updated, in ISO 8601 format.",
+				Computed:    true,
+			},
+			"addresses": schema.ListAttribute{
+				Description: "The addresses associated with the account, such as aliases.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MailAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MailAccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var twoFactorAuth types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("two_factor_auth"), &twoFactorAuth)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if twoFactorAuth.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("two_factor_auth"),
+			"Invalid Two-Factor Authentication Setting",
+			"Two-factor authentication can only be enabled by the mailbox owner in the Zone.EU web interface. "+
+				"Set two_factor_auth to false to disable it, or leave it unset.",
+		)
+	}
+}
+
+// mailAccountAttributes maps API field names to resource attributes for
+// reporting validation errors
+var mailAccountAttributes = rootAttributes("address", "password", "comment", "fwd_addresses", "spamlevel", "two_factor_auth")
+
+// mailAccountFromPlan builds the request body for creating or updating an
+// account. Unknown optional attributes are left for Zone.EU to default.
+func mailAccountFromPlan(ctx context.Context, data *MailAccountResourceModel) (*MailAccount, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	account := &MailAccount{
+		Address:      data.Address.ValueString(),
+		Password:     data.Password.ValueString(),
+		Comment:      data.Comment.ValueString(),
+		Spamlevel:    data.Spamlevel.ValueString(),
+		FwdAddresses: []string{},
+	}
+	if !data.FwdAddresses.IsNull() && !data.FwdAddresses.IsUnknown() {
+		diags.Append(data.FwdAddresses.ElementsAs(ctx, &account.FwdAddresses, false)...)
+	}
+	if !data.TwoFactorAuth.IsNull() && !data.TwoFactorAuth.IsUnknown() {
+		account.TwoFactorAuth = data.TwoFactorAuth.ValueBoolPointer()
+	}
+	return account, diags
+}
+
+// canonicalMailAddress returns the canonical form of an e-mail address: lower
+// case with the domain in its ASCII (punycode) form. The API returns domains
+// in unicode form, while configurations may use either.
+func canonicalMailAddress(address string) string {
+	address = strings.ToLower(strings.TrimSpace(address))
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return address
+	}
+	domain, err := idna.Lookup.ToASCII(address[at+1:])
+	if err != nil {
+		return address
+	}
+	return address[:at+1] + domain
+}
+
+// normalizedMailAddress returns the address to store in state for an account
+// the API returned as apiAddress. The prior (configured) form is kept when it
+// refers to the same address, like normalizedRecordName does for DNS names.
+func normalizedMailAddress(prior types.String, apiAddress string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && canonicalMailAddress(prior.ValueString()) == canonicalMailAddress(apiAddress) {
+		return prior
+	}
+	return types.StringValue(apiAddress)
+}
+
+// setMailAccountState copies the API representation of an account into the
+// model. The password is never returned and is left as is.
+func setMailAccountState(ctx context.Context, data *MailAccountResourceModel, service string, account *MailAccount) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, account.Address))
+	data.Service = types.StringValue(service)
+	data.Address = normalizedMailAddress(data.Address, account.Address)
+	data.Comment = types.StringValue(account.Comment)
+	data.Spamlevel = types.StringValue(account.Spamlevel)
+	data.TwoFactorAuth = types.BoolValue(account.TwoFactorAuth != nil && *account.TwoFactorAuth)
+	data.Autoreply = types.BoolValue(account.Autoreply)
+	data.DiskSize = types.Int64Value(account.DiskSize)
+	data.DiskSizeHuman = types.StringValue(account.DiskSizeHuman)
+	data.DiskUsage = types.Int64Value(account.DiskUsage)
+	data.DiskUsageHuman = types.StringValue(account.DiskUsageHuman)
+	data.DiskUsageUpdated = types.StringValue(account.DiskUsageUpdated)
+
+	fwdAddresses := account.FwdAddresses
+	if fwdAddresses == nil {
+		fwdAddresses = []string{}
+	}
+	data.FwdAddresses, d = types.SetValueFrom(ctx, types.StringType, fwdAddresses)
+	diags.Append(d...)
+
+	addresses := []attr.Value{}
+	for _, a := range account.Addresses {
+		addresses = append(addresses, types.StringValue(a))
+	}
+	data.Addresses, d = types.ListValue(types.StringType, addresses)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *MailAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	account, diags := mailAccountFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.CreateMailAccount(service, account); err != nil {
+		detail := fmt.Sprintf("Could not create mail account %s on %s: %s", account.Address, service, err)
+		if IsPaymentRequired(err) {
+			detail += "\n\nThe e-mail account limit of the webhosting plan has been reached."
+		}
+		addAPIError(&resp.Diagnostics, "Error Creating Mail Account", detail, err, mailAccountAttributes)
+		return
+	}
+
+	// The create response does not include the associated addresses
+	created, err := r.client.GetMailAccount(service, account.Address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Account",
+			fmt.Sprintf("Could not read mail account %s on %s after create: %s", account.Address, service, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailAccountState(ctx, &data, service, created)...)
+
+	tflog.Trace(ctx, "created mail account", map[string]interface{}{
+		"service": service,
+		"address": created.Address,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	account, err := r.client.GetMailAccount(service, data.Address.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Account",
+			fmt.Sprintf("Could not read mail account %s on %s: %s", data.Address.ValueString(), service, err),
+		)
+		return
+	}
+
+	// An archived account no longer receives mail
+	if account.DeletedAt != "" {
+		tflog.Warn(ctx, "mail account is archived, removing from state", map[string]interface{}{
+			"service":    service,
+			"address":    account.Address,
+			"deleted_at": account.DeletedAt,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailAccountState(ctx, &data, service, account)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MailAccountResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	account, diags := mailAccountFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the password when it changes, so unrelated updates do not
+	// reset it
+	if data.Password.Equal(state.Password) {
+		account.Password = ""
+	}
+
+	if _, err := r.client.UpdateMailAccount(service, state.Address.ValueString(), account); err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating Mail Account",
+			fmt.Sprintf("Could not update mail account %s on %s: %s", state.Address.ValueString(), service, err),
+			err, mailAccountAttributes,
+		)
+		return
+	}
+
+	updated, err := r.client.GetMailAccount(service, account.Address)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Account",
+			fmt.Sprintf("Could not read mail account %s on %s after update: %s", account.Address, service, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailAccountState(ctx, &data, service, updated)...)
+
+	tflog.Trace(ctx, "updated mail account", map[string]interface{}{
+		"service": service,
+		"address": updated.Address,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailAccountResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMailAccount(data.Service.ValueString(), data.Address.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Mail Account",
+			fmt.Sprintf("Could not delete mail account %s on %s: %s", data.Address.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted mail account", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+}
+
+func (r *MailAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/address
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/address', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource = &MailAccountAppPasswordRevocationResource{}
+)
+
+func NewMailAccountAppPasswordRevocationResource() resource.Resource {
+	return &MailAccountAppPasswordRevocationResource{}
+}
+
+// MailAccountAppPasswordRevocationResource revokes application specific
+// passwords of a mail account. Revoked passwords cannot be restored, so the
+// resource only acts on create and update.
+type MailAccountAppPasswordRevocationResource struct {
+	client *Client
+}
+
+type MailAccountAppPasswordRevocationResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Service types.String `tfsdk:"service"`
+	Address types.String `tfsdk:"address"`
+	IDs     types.Set    `tfsdk:"ids"`
+}
+
+func (r *MailAccountAppPasswordRevocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_account_app_password_revocation"
+}
+
+func (r *MailAccountAppPasswordRevocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes application specific passwords of a Zone.EU mail account. " +
+			"Every password in ids is revoked when the resource is created, and passwords added to ids are revoked on update. " +
+			"Combine with the zoneeu_mail_account_app_passwords data source to revoke stale passwords. " +
+			"Revoked passwords cannot be restored: destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/address'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the mail account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ids": schema.SetAttribute{
+				Description: "The IDs of the application passwords to revoke. Passwords that no longer exist are ignored.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *MailAccountAppPasswordRevocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// appPasswordsToRevoke returns the planned IDs that were not already revoked
+// by a previous apply, sorted
+func appPasswordsToRevoke(planned, revoked []string) []string {
+	done := make(map[string]bool, len(revoked))
+	for _, id := range revoked {
+		done[id] = true
+	}
+
+	var ids []string
+	for _, id := range planned {
+		if !done[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// revoke deletes the given application passwords. Passwords that have
+// already been deleted are skipped.
+func (r *MailAccountAppPasswordRevocationResource) revoke(ctx context.Context, service, address string, ids []string) error {
+	for _, id := range ids {
+		err := r.client.DeleteMailAccountAppPassword(service, address, id)
+		if err != nil && !IsNotFound(err) {
+			return fmt.Errorf("application password %s: %w", id, err)
+		}
+
+		tflog.Trace(ctx, "revoked application password", map[string]interface{}{
+			"service": service,
+			"address": address,
+			"id":      id,
+			"existed": err == nil,
+		})
+	}
+	return nil
+}
+
+func (r *MailAccountAppPasswordRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailAccountAppPasswordRevocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	var planned []string
+	resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.revoke(ctx, service, address, appPasswordsToRevoke(planned, nil)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Application Passwords",
+			fmt.Sprintf("Could not revoke application passwords of %s on %s: %s", address, service, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, address))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is: revoked passwords no longer exist, so there is
+// nothing to read back
+func (r *MailAccountAppPasswordRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *MailAccountAppPasswordRevocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MailAccountAppPasswordRevocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	var planned, revoked []string
+	resp.Diagnostics.Append(data.IDs.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &revoked, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.revoke(ctx, service, address, appPasswordsToRevoke(planned, revoked)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Application Passwords",
+			fmt.Sprintf("Could not revoke application passwords of %s on %s: %s", address, service, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, revoked passwords cannot be
+// restored
+func (r *MailAccountAppPasswordRevocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppPasswordsToRevoke(t *testing.T) {
+	if got := strings.Join(appPasswordsToRevoke([]string{"3", "1", "2"}, nil), ","); got != "1,2,3" {
+		t.Errorf("expected every planned ID on create, got %s", got)
+	}
+	if got := strings.Join(appPasswordsToRevoke([]string{"1", "4"}, []string{"1", "2"}), ","); got != "4" {
+		t.Errorf("expected only newly added IDs on update, got %s", got)
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MailAccountPremiumResource{}
+	_ resource.ResourceWithImportState = &MailAccountPremiumResource{}
+	_ resource.ResourceWithModifyPlan  = &MailAccountPremiumResource{}
+)
+
+func NewMailAccountPremiumResource() resource.Resource {
+	return &MailAccountPremiumResource{}
+}
+
+// MailAccountPremiumResource manages the premium package of a mail account
+type MailAccountPremiumResource struct {
+	client *Client
+}
+
+type MailAccountPremiumResourceModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Service        types.String  `tfsdk:"service"`
+	Address        types.String  `tfsdk:"address"`
+	Package        types.String  `tfsdk:"package"`
+	PackageAllowed types.Bool    `tfsdk:"package_allowed"`
+	DiskSize       types.Int64   `tfsdk:"disk_size"`
+	DiskSizeHuman  types.String  `tfsdk:"disk_size_human"`
+	PriceMonth     types.Float64 `tfsdk:"price_month"`
+	PriceYear      types.Float64 `tfsdk:"price_year"`
+}
+
+func (r *MailAccountPremiumResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_account_premium"
+}
+
+func (r *MailAccountPremiumResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the premium package of a Zone.EU mail account. Premium packages are billed; " +
+			"the package is checked against the packages available for the account during plan. " +
+			"Destroying the resource turns the premium package off.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/address'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the mail account.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package": schema.StringAttribute{
+				Description: fmt.Sprintf("The handle of the premium package, or %q for none. "+
+					"The available handles are listed by Zone.EU for each account.", MailPremiumNone),
+				Required: true,
+			},
+			"package_allowed": schema.BoolAttribute{
+				Description: "Whether the package is allowed for the account.",
+				Computed:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: "The disk size of the mailbox with the package, in bytes.",
+				Computed:    true,
+			},
+			"disk_size_human": schema.StringAttribute{
+				Description: "The disk size of the mailbox with the package, in human readable form.",
+				Computed:    true,
+			},
+			"price_month": schema.Float64Attribute{
+				Description: "The monthly price of the package.",
+				Computed:    true,
+			},
+			"price_year": schema.Float64Attribute{
+				Description: "The yearly price of the package.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MailAccountPremiumResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// checkPremiumPackage checks a package handle against the packages available
+// for an account and returns a description of the problem, or "" if the
+// package can be activated
+func checkPremiumPackage(pkg string, options []MailAccountPremium) string {
+	if pkg == MailPremiumNone {
+		return ""
+	}
+
+	var handles []string
+	for _, option := range options {
+		if option.Package != pkg {
+			handles = append(handles, option.Package)
+			continue
+		}
+		if !option.PackageAllowed {
+			return fmt.Sprintf("Premium package %q is not allowed for this account.", pkg)
+		}
+		return ""
+	}
+
+	if len(handles) == 0 {
+		return fmt.Sprintf("Premium package %q is not available: no premium packages are available for this account.", pkg)
+	}
+	return fmt.Sprintf("Premium package %q is not available, expected %q or one of: %s.", pkg, MailPremiumNone, strings.Join(handles, ", "))
+}
+
+// ModifyPlan checks the package against the packages Zone.EU offers for the
+// account, so that a mistyped or disallowed handle is reported during plan
+// instead of apply
+func (r *MailAccountPremiumResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data MailAccountPremiumResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Service.IsUnknown() || data.Package.IsUnknown() {
+		return
+	}
+	if data.Package.ValueString() == MailPremiumNone {
+		return
+	}
+	service := data.Service.ValueString()
+
+	// An account that does not exist yet, e.g. one created in the same run,
+	// gets the packages offered for new accounts of the service
+	address := "@" + service
+	if !data.Address.IsUnknown() {
+		address = data.Address.ValueString()
+	}
+	options, err := r.client.GetMailAccountPremiumOptions(service, address)
+	if err != nil && IsNotFound(err) && address != "@"+service {
+		options, err = r.client.GetMailAccountPremiumOptions(service, "@"+service)
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Premium Package",
+			fmt.Sprintf("Could not list the premium packages available for %s on %s, the package will only be checked during apply: %s", address, service, err),
+		)
+		return
+	}
+
+	if problem := checkPremiumPackage(data.Package.ValueString(), options); problem != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("package"), "Invalid Premium Package", problem)
+	}
+}
+
+// setMailAccountPremiumState copies the API representation of a premium
+// status into the model
+func setMailAccountPremiumState(data *MailAccountPremiumResourceModel, service, address string, premium *MailAccountPremium) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, address))
+	data.Service = types.StringValue(service)
+	data.Address = types.StringValue(address)
+	data.Package = types.StringValue(premium.Package)
+	data.PackageAllowed = types.BoolValue(premium.PackageAllowed)
+	data.DiskSize = types.Int64Value(premium.DiskSize)
+	data.DiskSizeHuman = types.StringValue(premium.DiskSizeHuman)
+	data.PriceMonth = types.Float64Value(premium.PriceMonth)
+	data.PriceYear = types.Float64Value(premium.PriceYear)
+}
+
+// apply activates the planned package and reads the resulting status
+func (r *MailAccountPremiumResource) apply(data *MailAccountPremiumResourceModel) (*MailAccountPremium, error) {
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	if err := r.client.SetMailAccountPremium(service, address, data.Package.ValueString()); err != nil {
+		return nil, err
+	}
+	return r.client.GetMailAccountPremium(service, address)
+}
+
+func (r *MailAccountPremiumResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailAccountPremiumResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	premium, err := r.apply(&data)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Creating Mail Account Premium",
+			fmt.Sprintf("Could not set premium package %s for %s on %s: %s", data.Package.ValueString(), address, service, err),
+			err, rootAttributes("package"),
+		)
+		return
+	}
+
+	setMailAccountPremiumState(&data, service, address, premium)
+
+	tflog.Trace(ctx, "set mail account premium package", map[string]interface{}{
+		"service": service,
+		"address": address,
+		"package": premium.Package,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountPremiumResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailAccountPremiumResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	premium, err := r.client.GetMailAccountPremium(service, address)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Account Premium",
+			fmt.Sprintf("Could not read premium status of %s on %s: %s", address, service, err),
+		)
+		return
+	}
+
+	setMailAccountPremiumState(&data, service, address, premium)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountPremiumResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailAccountPremiumResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	address := data.Address.ValueString()
+
+	premium, err := r.apply(&data)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating Mail Account Premium",
+			fmt.Sprintf("Could not set premium package %s for %s on %s: %s", data.Package.ValueString(), address, service, err),
+			err, rootAttributes("package"),
+		)
+		return
+	}
+
+	setMailAccountPremiumState(&data, service, address, premium)
+
+	tflog.Trace(ctx, "updated mail account premium package", map[string]interface{}{
+		"service": service,
+		"address": address,
+		"package": premium.Package,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAccountPremiumResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailAccountPremiumResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetMailAccountPremium(data.Service.ValueString(), data.Address.ValueString(), MailPremiumNone)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Mail Account Premium",
+			fmt.Sprintf("Could not turn off premium package of %s on %s: %s", data.Address.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "turned off mail account premium package", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+}
+
+func (r *MailAccountPremiumResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/address
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/address', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPremiumPackage(t *testing.T) {
+	options := []MailAccountPremium{
+		{Package: "premium-50", PackageAllowed: true},
+		{Package: "premium-100", PackageAllowed: false},
+	}
+
+	tests := []struct {
+		name     string
+		pkg      string
+		options  []MailAccountPremium
+		expected string
+	}{
+		{"none is always valid", MailPremiumNone, nil, ""},
+		{"available", "premium-50", options, ""},
+		{"not allowed", "premium-100", options, "not allowed"},
+		{"unknown lists handles", "premium-5", options, "premium-50, premium-100"},
+		{"no packages", "premium-50", nil, "no premium packages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := checkPremiumPackage(tt.pkg, tt.options)
+			if tt.expected == "" && problem != "" {
+				t.Errorf("expected no problem, got %q", problem)
+			}
+			if !strings.Contains(problem, tt.expected) {
+				t.Errorf("expected problem containing %q, got %q", tt.expected, problem)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMailAccountFromPlan(t *testing.T) {
+	ctx := context.Background()
+	fwd, _ := types.SetValueFrom(ctx, types.StringType, []string{"archive@example.net"})
+
+	account, diags := mailAccountFromPlan(ctx, &MailAccountResourceModel{
+		Address:       types.StringValue("info@example.com"),
+		Password:      types.StringValue("correct-horse-battery"),
+		Comment:       types.StringUnknown(),
+		FwdAddresses:  fwd,
+		Spamlevel:     types.StringUnknown(),
+		TwoFactorAuth: types.BoolUnknown(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(account.FwdAddresses) != 1 || account.FwdAddresses[0] != "archive@example.net" {
+		t.Errorf("unexpected fwd_addresses: %v", account.FwdAddresses)
+	}
+	if account.Spamlevel != "" || account.TwoFactorAuth != nil {
+		t.Errorf("expected unknown attributes to be left unset, got %+v", account)
+	}
+
+	account, diags = mailAccountFromPlan(ctx, &MailAccountResourceModel{
+		Address:       types.StringValue("info@example.com"),
+		FwdAddresses:  types.SetNull(types.StringType),
+		TwoFactorAuth: types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if account.FwdAddresses == nil || len(account.FwdAddresses) != 0 {
+		t.Errorf("expected empty fwd_addresses to clear forwarding, got %v", account.FwdAddresses)
+	}
+	if account.TwoFactorAuth == nil || *account.TwoFactorAuth {
+		t.Error("expected two_factor_auth to be disabled")
+	}
+}
+
+func TestSetMailAccountState(t *testing.T) {
+	ctx := context.Background()
+	data := MailAccountResourceModel{Password: types.StringValue("correct-horse-battery")}
+
+	diags := setMailAccountState(ctx, &data, "example.com", &MailAccount{
+		Address:        "info@example.com",
+		DiskUsageHuman: "1 KB",
+		Addresses:      []string{"info@example.com"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.ID.ValueString() != "example.com/info@example.com" {
+		t.Errorf("unexpected id: %s", data.ID.ValueString())
+	}
+	if data.Password.ValueString() != "correct-horse-battery" {
+		t.Error("expected password to be kept")
+	}
+	if data.FwdAddresses.IsNull() || len(data.FwdAddresses.Elements()) != 0 {
+		t.Errorf("expected empty fwd_addresses, got %v", data.FwdAddresses)
+	}
+	if data.TwoFactorAuth.ValueBool() {
+		t.Error("expected two_factor_auth false")
+	}
+	if len(data.Addresses.Elements()) != 1 {
+		t.Errorf("unexpected addresses: %v", data.Addresses)
+	}
+}
+
+func TestNormalizedMailAddress(t *testing.T) {
+	tests := []struct {
+		prior    types.String
+		api      string
+		expected string
+	}{
+		{types.StringValue("info@xn--tst-qla.ee"), "info@täst.ee", "info@xn--tst-qla.ee"},
+		{types.StringValue("Info@Example.com"), "info@example.com", "Info@Example.com"},
+		{types.StringValue("info@täst.ee"), "info@täst.ee", "info@täst.ee"},
+		{types.StringValue("sales@example.com"), "info@example.com", "info@example.com"},
+		{types.StringNull(), "info@täst.ee", "info@täst.ee"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizedMailAddress(tt.prior, tt.api); got.ValueString() != tt.expected {
+			t.Errorf("normalizedMailAddress(%s, %q) = %q, expected %q", tt.prior, tt.api, got.ValueString(), tt.expected)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &MailAutoreplyResource{}
+	_ resource.ResourceWithImportState    = &MailAutoreplyResource{}
+	_ resource.ResourceWithValidateConfig = &MailAutoreplyResource{}
+)
+
+// mailKinds are the mailbox kinds an autoreply can be set on
+var mailKinds = []string{MailAccountKind, MailForwarderKind}
+
+// autoreplyDateRegexp matches the yyyy-mm-dd dates of an autoreply
+var autoreplyDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewMailAutoreplyResource() resource.Resource {
+	return &MailAutoreplyResource{}
+}
+
+// MailAutoreplyResource manages the autoreply of a mail account or forwarder.
+// Every mailbox has exactly one autoreply, so the resource updates it on
+// create and disables it on delete.
+type MailAutoreplyResource struct {
+	client *Client
+}
+
+type MailAutoreplyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Service   types.String `tfsdk:"service"`
+	Kind      types.String `tfsdk:"kind"`
+	Address   types.String `tfsdk:"address"`
+	IsEnabled types.Bool   `tfsdk:"is_enabled"`
+	Fromname  types.String `tfsdk:"fromname"`
+	Subject   types.String `tfsdk:"subject"`
+	Body      types.String `tfsdk:"body"`
+	Datestart types.String `tfsdk:"datestart"`
+	Dateend   types.String `tfsdk:"dateend"`
+}
+
+func (r *MailAutoreplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_autoreply"
+}
+
+func (r *MailAutoreplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(autoreplyDateRegexp, "must be a date in yyyy-mm-dd format"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the autoreply of a mail account or forwarder of a Zone.EU webhosting service. " +
+			"Destroying the resource disables the autoreply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/kind/address'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "Whether address is a mail account or a mail forwarder: " + strings.Join(mailKinds, ", ") + ". Defaults to account.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(MailAccountKind),
+				Validators: []validator.String{
+					stringvalidator.OneOf(mailKinds...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the mail account or forwarder.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description: "Whether the autoreply is enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"fromname": schema.StringAttribute{
+				Description: "The sender name of the autoreply.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"subject": schema.StringAttribute{
+				Description: "The subject of the autoreply.",
+				Required:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The body of the autoreply.",
+				Required:    true,
+			},
+			"datestart": schema.StringAttribute{
+				Description: "The first day the autoreply is sent, in yyyy-mm-dd format. Sent from now on if not set.",
+				Optional:    true,
+				Validators:  dateValidators,
+			},
+			"dateend": schema.StringAttribute{
+				Description: "The last day the autoreply is sent, in yyyy-mm-dd format. Sent until disabled if not set.",
+				Optional:    true,
+				Validators:  dateValidators,
+			},
+		},
+	}
+}
+
+func (r *MailAutoreplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MailAutoreplyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var datestart, dateend types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("datestart"), &datestart)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dateend"), &dateend)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if datestart.IsNull() || datestart.IsUnknown() || dateend.IsNull() || dateend.IsUnknown() {
+		return
+	}
+	// yyyy-mm-dd dates sort lexically
+	if dateend.ValueString() < datestart.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dateend"),
+			"Invalid Autoreply Period",
+			fmt.Sprintf("dateend (%s) is before datestart (%s).", dateend.ValueString(), datestart.ValueString()),
+		)
+	}
+}
+
+// mailAutoreplyAttributes maps API field names to resource attributes for
+// reporting validation errors
+var mailAutoreplyAttributes = rootAttributes("is_enabled", "fromname", "subject", "body", "datestart", "dateend")
+
+// mailAutoreplyFromPlan builds the request body for setting an autoreply
+func mailAutoreplyFromPlan(data *MailAutoreplyResourceModel) *MailAutoreply {
+	return &MailAutoreply{
+		IsEnabled: data.IsEnabled.ValueBool(),
+		Fromname:  data.Fromname.ValueString(),
+		Subject:   data.Subject.ValueString(),
+		Body:      data.Body.ValueString(),
+		Datestart: data.Datestart.ValueString(),
+		Dateend:   data.Dateend.ValueString(),
+	}
+}
+
+// setMailAutoreplyState copies the API representation of an autoreply into
+// the model. Empty dates are null.
+func setMailAutoreplyState(data *MailAutoreplyResourceModel, autoreply *MailAutoreply) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Service.ValueString(), data.Kind.ValueString(), data.Address.ValueString()))
+	data.IsEnabled = types.BoolValue(autoreply.IsEnabled)
+	data.Fromname = types.StringValue(autoreply.Fromname)
+	data.Subject = types.StringValue(autoreply.Subject)
+	data.Body = types.StringValue(autoreply.Body)
+	data.Datestart = types.StringNull()
+	if autoreply.Datestart != "" {
+		data.Datestart = types.StringValue(autoreply.Datestart)
+	}
+	data.Dateend = types.StringNull()
+	if autoreply.Dateend != "" {
+		data.Dateend = types.StringValue(autoreply.Dateend)
+	}
+}
+
+func (r *MailAutoreplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailAutoreplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoreply, err := r.client.UpdateMailAutoreply(data.Service.ValueString(), data.Kind.ValueString(), data.Address.ValueString(), mailAutoreplyFromPlan(&data))
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Creating Mail Autoreply",
+			fmt.Sprintf("Could not set autoreply of mail %s %s on %s: %s", data.Kind.ValueString(), data.Address.ValueString(), data.Service.ValueString(), err),
+			err, mailAutoreplyAttributes,
+		)
+		return
+	}
+	setMailAutoreplyState(&data, autoreply)
+
+	tflog.Trace(ctx, "set mail autoreply", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"kind":    data.Kind.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAutoreplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailAutoreplyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoreply, err := r.client.GetMailAutoreply(data.Service.ValueString(), data.Kind.ValueString(), data.Address.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Autoreply",
+			fmt.Sprintf("Could not read autoreply of mail %s %s on %s: %s", data.Kind.ValueString(), data.Address.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+	setMailAutoreplyState(&data, autoreply)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAutoreplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailAutoreplyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoreply, err := r.client.UpdateMailAutoreply(data.Service.ValueString(), data.Kind.ValueString(), data.Address.ValueString(), mailAutoreplyFromPlan(&data))
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating Mail Autoreply",
+			fmt.Sprintf("Could not update autoreply of mail %s %s on %s: %s", data.Kind.ValueString(), data.Address.ValueString(), data.Service.ValueString(), err),
+			err, mailAutoreplyAttributes,
+		)
+		return
+	}
+	setMailAutoreplyState(&data, autoreply)
+
+	tflog.Trace(ctx, "updated mail autoreply", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"kind":    data.Kind.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailAutoreplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailAutoreplyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The autoreply cannot be deleted, only disabled
+	autoreply := mailAutoreplyFromPlan(&data)
+	autoreply.IsEnabled = false
+
+	_, err := r.client.UpdateMailAutoreply(data.Service.ValueString(), data.Kind.ValueString(), data.Address.ValueString(), autoreply)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Disabling Mail Autoreply",
+			fmt.Sprintf("Could not disable autoreply of mail %s %s on %s: %s", data.Kind.ValueString(), data.Address.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "disabled mail autoreply", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"kind":    data.Kind.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+}
+
+func (r *MailAutoreplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/kind/address
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || (parts[1] != MailAccountKind && parts[1] != MailForwarderKind) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/kind/address' with kind account or forwarder, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetMailAutoreplyState(t *testing.T) {
+	data := MailAutoreplyResourceModel{
+		Service:   types.StringValue("example.com"),
+		Kind:      types.StringValue(MailForwarderKind),
+		Address:   types.StringValue("sales@example.com"),
+		Datestart: types.StringValue("2026-12-20"),
+	}
+
+	setMailAutoreplyState(&data, &MailAutoreply{
+		IsEnabled: true,
+		Subject:   "Closed for the holidays",
+		Dateend:   "2027-01-04",
+	})
+
+	if data.ID.ValueString() != "example.com/forwarder/sales@example.com" {
+		t.Errorf("unexpected id: %s", data.ID.ValueString())
+	}
+	if !data.Datestart.IsNull() {
+		t.Errorf("expected empty datestart to be null, got %s", data.Datestart)
+	}
+	if data.Dateend.ValueString() != "2027-01-04" {
+		t.Errorf("unexpected dateend: %s", data.Dateend)
+	}
+	if data.Fromname.IsNull() || data.Fromname.ValueString() != "" {
+		t.Errorf("expected empty fromname, got %s", data.Fromname)
+	}
+
+	autoreply := mailAutoreplyFromPlan(&data)
+	if !autoreply.IsEnabled || autoreply.Datestart != "" || autoreply.Dateend != "2027-01-04" {
+		t.Errorf("unexpected autoreply: %+v", autoreply)
+	}
+}
@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MailDKIMResource{}
+	_ resource.ResourceWithImportState = &MailDKIMResource{}
+	_ resource.ResourceWithModifyPlan  = &MailDKIMResource{}
+)
+
+func NewMailDKIMResource() resource.Resource {
+	return &MailDKIMResource{}
+}
+
+// MailDKIMResource enables DKIM signing for a webhosting service and can
+// publish the key as a <selector>._domainkey TXT record
+type MailDKIMResource struct {
+	client *Client
+}
+
+type MailDKIMResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Service         types.String `tfsdk:"service"`
+	ManageDNSRecord types.Bool   `tfsdk:"manage_dns_record"`
+	Zone            types.String `tfsdk:"zone"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Selector        types.String `tfsdk:"selector"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	TXTName         types.String `tfsdk:"txt_name"`
+	TXTValue        types.String `tfsdk:"txt_value"`
+	DNSRecordID     types.String `tfsdk:"dns_record_id"`
+}
+
+// dnsZone returns the zone of the TXT record: zone if set, otherwise the
+// service name
+func (m *MailDKIMResourceModel) dnsZone() string {
+	if !m.Zone.IsNull() && !m.Zone.IsUnknown() {
+		return m.Zone.ValueString()
+	}
+	return m.Service.ValueString()
+}
+
+func (r *MailDKIMResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_dkim"
+}
+
+func (r *MailDKIMResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables DKIM signing for a Zone.EU webhosting service and exports its selector and public key. " +
+			"With manage_dns_record, the matching <selector>._domainkey TXT record is created and kept up to date. " +
+			"Destroying the resource deletes the DKIM key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this resource (same as service).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manage_dns_record": schema.BoolAttribute{
+				Description: "Whether to publish the key as a TXT record in zone. An existing DKIM record with the same name is taken over. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"zone": schema.StringAttribute{
+				Description: "The DNS zone to publish the TXT record in. Defaults to service.",
+				Optional:    true,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that generate a new key when changed, for key rotation.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector": schema.StringAttribute{
+				Description: "The DKIM selector.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The DKIM public key as returned by Zone.EU.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"txt_name": schema.StringAttribute{
+				Description: "The name of the TXT record publishing the key (<selector>._domainkey.<zone>).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"txt_value": schema.StringAttribute{
+				Description: "The value of the TXT record publishing the key. If the managed record was changed outside Terraform, its current value until the next apply.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_record_id": schema.StringAttribute{
+				Description: "The ID of the managed TXT record, if manage_dns_record is true. Null if the record was deleted outside Terraform, until the next apply.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MailDKIMResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the computed record attributes on update: txt_name follows
+// zone, dns_record_id is null without manage_dns_record and unknown when the
+// record will be (re)created, and txt_value returns to the value of the key
+// when Read reported a changed record.
+func (r *MailDKIMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to adjust on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state MailDKIMResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ManageDNSRecord.IsUnknown() || plan.Zone.IsUnknown() {
+		plan.TXTName = types.StringUnknown()
+		plan.DNSRecordID = types.StringUnknown()
+	} else {
+		plan.TXTName = types.StringValue(dkimRecordName(plan.dnsZone(), state.Selector.ValueString()))
+		switch {
+		case !plan.ManageDNSRecord.ValueBool():
+			plan.DNSRecordID = types.StringNull()
+		case state.DNSRecordID.IsNull() || plan.dnsZone() != state.dnsZone():
+			plan.DNSRecordID = types.StringUnknown()
+		}
+	}
+
+	// txt_value may hold the value of a record changed outside Terraform,
+	// which is replaced by the value of the key on update
+	if state.ManageDNSRecord.ValueBool() && !state.DNSRecordID.IsNull() {
+		plan.TXTValue = types.StringUnknown()
+		if r.client != nil {
+			if dkim, err := r.client.GetMailDKIM(plan.Service.ValueString()); err == nil {
+				plan.TXTValue = types.StringValue(dkim.TXTValue())
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// dkimRecordName returns the name of the TXT record publishing a key
+func dkimRecordName(zone, selector string) string {
+	return canonicalRecordName(zone, selector+"._domainkey")
+}
+
+// setMailDKIMState copies a DKIM key into the model
+func setMailDKIMState(data *MailDKIMResourceModel, dkim *MailDKIM) {
+	data.ID = types.StringValue(data.Service.ValueString())
+	data.Selector = types.StringValue(dkim.Selector)
+	data.PublicKey = types.StringValue(dkim.PublicKey)
+	data.TXTName = types.StringValue(dkimRecordName(data.dnsZone(), dkim.Selector))
+	data.TXTValue = types.StringValue(dkim.TXTValue())
+}
+
+// syncDNSRecord creates or updates the TXT record publishing the key and
+// returns its ID. The record id is updated if it still exists; otherwise an
+// existing DKIM record of the same name is taken over, or a new one created.
+func (r *MailDKIMResource) syncDNSRecord(ctx context.Context, data *MailDKIMResourceModel, id string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	zone := data.dnsZone()
+	txt := r.client.Records(dnsRecordTypeTXT.Type)
+	record := &DNSRecord{
+		Name:        data.TXTName.ValueString(),
+		Destination: data.TXTValue.ValueString(),
+	}
+
+	var existing *DNSRecord
+	if id != "" {
+		current, err := txt.Get(ctx, zone, id)
+		switch {
+		case err == nil:
+			existing = current
+		case !IsNotFound(err):
+			diags.AddError("Error Reading DKIM Record", fmt.Sprintf("Could not read TXT record %s in zone %s: %s", id, zone, err))
+			return "", diags
+		}
+	}
+	if existing == nil {
+		records, err := txt.FindAllByName(ctx, zone, record.Name)
+		if err != nil {
+			diags.AddError("Error Reading DKIM Record", fmt.Sprintf("Could not list TXT records of %s: %s", record.Name, err))
+			return "", diags
+		}
+		for i := range records {
+			if strings.HasPrefix(records[i].Destination, "v=DKIM1") {
+				existing = &records[i]
+				break
+			}
+		}
+	}
+
+	if existing == nil {
+		created, err := txt.Create(ctx, zone, record)
+		if err != nil {
+			addAPIError(&diags, "Error Creating DKIM Record",
+				fmt.Sprintf("Could not create TXT record %s: %s", record.Name, err),
+				err, nil)
+			return "", diags
+		}
+		tflog.Info(ctx, "created DKIM TXT record", map[string]interface{}{"zone": zone, "name": record.Name, "record_id": created.ID})
+		return created.ID, diags
+	}
+
+	if existing.Destination != record.Destination || !recordNamesEqual(zone, existing.Name, record.Name) {
+		if _, err := txt.Update(ctx, zone, existing.ID, record); err != nil {
+			addAPIError(&diags, "Error Updating DKIM Record",
+				fmt.Sprintf("Could not update TXT record %s (%s): %s", record.Name, existing.ID, err),
+				err, nil)
+			return "", diags
+		}
+		tflog.Info(ctx, "updated DKIM TXT record", map[string]interface{}{"zone": zone, "name": record.Name, "record_id": existing.ID})
+	}
+	return existing.ID, diags
+}
+
+// refreshDNSRecord reports a managed TXT record that was deleted or changed
+// outside Terraform through the computed attributes: dns_record_id becomes
+// null and txt_value takes the live value, so that the next plan updates it.
+func (r *MailDKIMResource) refreshDNSRecord(ctx context.Context, data *MailDKIMResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !data.ManageDNSRecord.ValueBool() || data.DNSRecordID.IsNull() {
+		return diags
+	}
+
+	record, err := r.client.Records(dnsRecordTypeTXT.Type).Get(ctx, data.dnsZone(), data.DNSRecordID.ValueString())
+	switch {
+	case IsNotFound(err):
+		data.DNSRecordID = types.StringNull()
+	case err != nil:
+		diags.AddError(
+			"Error Reading DKIM Record",
+			fmt.Sprintf("Could not read TXT record %s in zone %s: %s", data.DNSRecordID.ValueString(), data.dnsZone(), err),
+		)
+	case record.Destination != data.TXTValue.ValueString():
+		data.TXTValue = types.StringValue(record.Destination)
+	}
+	return diags
+}
+
+// deleteDNSRecord deletes the managed TXT record, if it still exists
+func (r *MailDKIMResource) deleteDNSRecord(ctx context.Context, zone, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	err := r.client.Records(dnsRecordTypeTXT.Type).Delete(ctx, zone, id)
+	if err != nil && !IsNotFound(err) {
+		diags.AddError("Error Deleting DKIM Record", fmt.Sprintf("Could not delete TXT record %s in zone %s: %s", id, zone, err))
+	}
+	return diags
+}
+
+func (r *MailDKIMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailDKIMResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	if err := r.client.EnableMailDKIM(service); err != nil {
+		// DKIM may already be enabled, e.g. in the web interface
+		if _, getErr := r.client.GetMailDKIM(service); getErr != nil {
+			detail := fmt.Sprintf("Could not enable DKIM for %s: %s", service, err)
+			if IsNotSupported(err) {
+				detail += "\n\nZone.EU does not support DKIM for this service."
+			}
+			resp.Diagnostics.AddError("Error Enabling DKIM", detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"DKIM Already Enabled",
+			fmt.Sprintf("DKIM could not be enabled for %s (%s), but a key already exists; it has been adopted.", service, err),
+		)
+	}
+
+	dkim, err := r.client.GetMailDKIM(service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading DKIM Key",
+			fmt.Sprintf("Could not read DKIM key of %s after enabling it: %s", service, err),
+		)
+		return
+	}
+	if dkim.Selector == "" {
+		resp.Diagnostics.AddError(
+			"Error Reading DKIM Key",
+			fmt.Sprintf("Zone.EU did not return a DKIM selector for %s.", service),
+		)
+		return
+	}
+	setMailDKIMState(&data, dkim)
+
+	data.DNSRecordID = types.StringNull()
+	if data.ManageDNSRecord.ValueBool() {
+		id, diags := r.syncDNSRecord(ctx, &data, "")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			// Keep the key in state so it is not generated again
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		data.DNSRecordID = types.StringValue(id)
+	}
+
+	tflog.Trace(ctx, "enabled DKIM", map[string]interface{}{
+		"service":  service,
+		"selector": dkim.Selector,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailDKIMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailDKIMResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	dkim, err := r.client.GetMailDKIM(service)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading DKIM Key",
+			fmt.Sprintf("Could not read DKIM key of %s: %s", service, err),
+		)
+		return
+	}
+	setMailDKIMState(&data, dkim)
+
+	resp.Diagnostics.Append(r.refreshDNSRecord(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailDKIMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MailDKIMResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dkim, err := r.client.GetMailDKIM(data.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading DKIM Key",
+			fmt.Sprintf("Could not read DKIM key of %s: %s", data.Service.ValueString(), err),
+		)
+		return
+	}
+	setMailDKIMState(&data, dkim)
+
+	recordID := state.DNSRecordID.ValueString()
+	if recordID != "" && (!data.ManageDNSRecord.ValueBool() || data.dnsZone() != state.dnsZone()) {
+		resp.Diagnostics.Append(r.deleteDNSRecord(ctx, state.dnsZone(), recordID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		recordID = ""
+	}
+
+	data.DNSRecordID = types.StringNull()
+	if data.ManageDNSRecord.ValueBool() {
+		id, diags := r.syncDNSRecord(ctx, &data, recordID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.DNSRecordID = types.StringValue(id)
+	}
+
+	tflog.Trace(ctx, "updated DKIM", map[string]interface{}{
+		"service":  data.Service.ValueString(),
+		"selector": dkim.Selector,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailDKIMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailDKIMResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id := data.DNSRecordID.ValueString(); id != "" {
+		resp.Diagnostics.Append(r.deleteDNSRecord(ctx, data.dnsZone(), id)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.client.DeleteMailDKIM(data.Service.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting DKIM Key",
+			fmt.Sprintf("Could not delete DKIM key of %s: %s", data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted DKIM key", map[string]interface{}{
+		"service": data.Service.ValueString(),
+	})
+}
+
+func (r *MailDKIMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manage_dns_record"), false)...)
+}
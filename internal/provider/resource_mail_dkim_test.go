@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMailDKIMTXTValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		dkim     MailDKIM
+		expected string
+	}{
+		{"bare key", MailDKIM{PublicKey: "MIGfMA0GCSqGSIb3"}, "v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3"},
+		{"pem key", MailDKIM{PublicKey: "-----BEGIN PUBLIC KEY-----\nMIGfMA0G\nCSqGSIb3\n-----END PUBLIC KEY-----\n"}, "v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3"},
+		{"record from api", MailDKIM{PublicKey: "ignored", Record: "v=DKIM1; k=rsa; t=s; p=MIGf"}, "v=DKIM1; k=rsa; t=s; p=MIGf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dkim.TXTValue(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMailDKIMSyncDNSRecord_MockServer(t *testing.T) {
+	var created, updated int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/txt":
+			json.NewEncoder(w).Encode([]DNSRecord{
+				{ID: "1", Name: "example.com", Destination: "v=spf1 -all"},
+				{ID: "2", Name: "zone1._domainkey.example.com", Destination: "v=DKIM1; k=rsa; p=OLD"},
+			})
+		case r.Method == "PUT" && r.URL.Path == "/dns/example.com/txt/2":
+			updated++
+			var record DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			if record.Destination != "v=DKIM1; k=rsa; p=NEW" {
+				t.Errorf("unexpected destination: %s", record.Destination)
+			}
+			record.ID = "2"
+			json.NewEncoder(w).Encode([]DNSRecord{record})
+		case r.Method == "POST" && r.URL.Path == "/dns/example.com/txt":
+			created++
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]DNSRecord{{ID: "3"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	r := &MailDKIMResource{client: client}
+
+	data := MailDKIMResourceModel{
+		Service: types.StringValue("example.com"),
+		Zone:    types.StringNull(),
+	}
+	setMailDKIMState(&data, &MailDKIM{Selector: "zone1", PublicKey: "NEW"})
+	if data.TXTName.ValueString() != "zone1._domainkey.example.com" {
+		t.Fatalf("unexpected txt_name: %s", data.TXTName.ValueString())
+	}
+
+	// The existing DKIM record of the same name is taken over and updated
+	id, diags := r.syncDNSRecord(context.Background(), &data, "")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if id != "2" || updated != 1 || created != 0 {
+		t.Errorf("expected record 2 to be updated, got id %s (%d updates, %d creates)", id, updated, created)
+	}
+}
+
+func TestMailDKIMRefreshDNSRecord_MockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/txt":
+			json.NewEncoder(w).Encode([]DNSRecord{{ID: "2", Name: "zone1._domainkey.example.com", Destination: "v=DKIM1; k=rsa; p=EDITED"}})
+		case r.Method == "GET" && r.URL.Path == "/dns/example.com/txt/3":
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode([]DNSRecord{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("testuser", "testapikey")
+	client.baseURL = server.URL
+	r := &MailDKIMResource{client: client}
+
+	newData := func(id string) MailDKIMResourceModel {
+		data := MailDKIMResourceModel{
+			Service:         types.StringValue("example.com"),
+			Zone:            types.StringNull(),
+			ManageDNSRecord: types.BoolValue(true),
+			DNSRecordID:     types.StringValue(id),
+		}
+		setMailDKIMState(&data, &MailDKIM{Selector: "zone1", PublicKey: "KEY"})
+		return data
+	}
+
+	// A changed record is reported through txt_value
+	changed := newData("2")
+	if diags := r.refreshDNSRecord(context.Background(), &changed); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if changed.TXTValue.ValueString() != "v=DKIM1; k=rsa; p=EDITED" || changed.DNSRecordID.ValueString() != "2" {
+		t.Errorf("expected the live value with record 2, got %s (%s)", changed.TXTValue, changed.DNSRecordID)
+	}
+	if !changed.ManageDNSRecord.ValueBool() {
+		t.Error("expected manage_dns_record to be left alone")
+	}
+
+	// A deleted record is reported through dns_record_id
+	deleted := newData("3")
+	if diags := r.refreshDNSRecord(context.Background(), &deleted); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !deleted.DNSRecordID.IsNull() || deleted.TXTValue.ValueString() != "v=DKIM1; k=rsa; p=KEY" {
+		t.Errorf("expected a null record ID and the key value, got %s (%s)", deleted.TXTValue, deleted.DNSRecordID)
+	}
+	if !deleted.ManageDNSRecord.ValueBool() {
+		t.Error("expected manage_dns_record to be left alone")
+	}
+}
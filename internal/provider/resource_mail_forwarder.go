@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MailForwarderResource{}
+	_ resource.ResourceWithImportState = &MailForwarderResource{}
+)
+
+func NewMailForwarderResource() resource.Resource {
+	return &MailForwarderResource{}
+}
+
+// MailForwarderResource manages an e-mail forwarder (an address without a
+// mailbox) of a webhosting service
+type MailForwarderResource struct {
+	client *Client
+}
+
+type MailForwarderResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Service       types.String `tfsdk:"service"`
+	Address       types.String `tfsdk:"address"`
+	Comment       types.String `tfsdk:"comment"`
+	FwdAddresses  types.Set    `tfsdk:"fwd_addresses"`
+	Autoreply     types.Bool   `tfsdk:"autoreply"`
+	MailToHTTPURL types.String `tfsdk:"mail_to_http_url"`
+}
+
+func (r *MailForwarderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_forwarder"
+}
+
+func (r *MailForwarderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an e-mail forwarder of a Zone.EU webhosting service: an address without a mailbox whose mail is forwarded to other addresses.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/address'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The e-mail address of the forwarder.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment for the forwarder.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fwd_addresses": schema.SetAttribute{
+				Description: "Addresses that mail is forwarded to.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"autoreply": schema.BoolAttribute{
+				Description: "Whether an autoreply is enabled for the forwarder. Manage it with zoneeu_mail_autoreply.",
+				Computed:    true,
+			},
+			"mail_to_http_url": schema.StringAttribute{
+				Description: "The URL incoming mail is posted to, if any.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MailForwarderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// mailForwarderAttributes maps API field names to resource attributes for
+// reporting validation errors
+var mailForwarderAttributes = rootAttributes("address", "comment", "fwd_addresses")
+
+// mailForwarderFromPlan builds the request body for creating or updating a
+// forwarder
+func mailForwarderFromPlan(ctx context.Context, data *MailForwarderResourceModel) (*MailForwarder, diag.Diagnostics) {
+	forwarder := &MailForwarder{
+		Address: data.Address.ValueString(),
+		Comment: data.Comment.ValueString(),
+	}
+	diags := data.FwdAddresses.ElementsAs(ctx, &forwarder.FwdAddresses, false)
+	return forwarder, diags
+}
+
+// setMailForwarderState copies the API representation of a forwarder into the
+// model
+func setMailForwarderState(ctx context.Context, data *MailForwarderResourceModel, service string, forwarder *MailForwarder) diag.Diagnostics {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, forwarder.Address))
+	data.Service = types.StringValue(service)
+	data.Address = types.StringValue(forwarder.Address)
+	data.Comment = types.StringValue(forwarder.Comment)
+	data.Autoreply = types.BoolValue(forwarder.Autoreply)
+	data.MailToHTTPURL = types.StringValue(forwarder.MailToHTTPURL)
+
+	fwdAddresses := forwarder.FwdAddresses
+	if fwdAddresses == nil {
+		fwdAddresses = []string{}
+	}
+	var diags diag.Diagnostics
+	data.FwdAddresses, diags = types.SetValueFrom(ctx, types.StringType, fwdAddresses)
+	return diags
+}
+
+func (r *MailForwarderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailForwarderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	forwarder, diags := mailForwarderFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateMailForwarder(service, forwarder)
+	if err != nil {
+		detail := fmt.Sprintf("Could not create mail forwarder %s on %s: %s", forwarder.Address, service, err)
+		if IsPaymentRequired(err) {
+			detail += "\n\nThe e-mail account limit of the webhosting plan has been reached."
+		}
+		addAPIError(&resp.Diagnostics, "Error Creating Mail Forwarder", detail, err, mailForwarderAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailForwarderState(ctx, &data, service, created)...)
+
+	tflog.Trace(ctx, "created mail forwarder", map[string]interface{}{
+		"service": service,
+		"address": created.Address,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailForwarderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailForwarderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	forwarder, err := r.client.GetMailForwarder(service, data.Address.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Mail Forwarder",
+			fmt.Sprintf("Could not read mail forwarder %s on %s: %s", data.Address.ValueString(), service, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailForwarderState(ctx, &data, service, forwarder)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailForwarderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailForwarderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	forwarder, diags := mailForwarderFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.UpdateMailForwarder(service, forwarder.Address, forwarder)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating Mail Forwarder",
+			fmt.Sprintf("Could not update mail forwarder %s on %s: %s", forwarder.Address, service, err),
+			err, mailForwarderAttributes,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMailForwarderState(ctx, &data, service, updated)...)
+
+	tflog.Trace(ctx, "updated mail forwarder", map[string]interface{}{
+		"service": service,
+		"address": updated.Address,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailForwarderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailForwarderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMailForwarder(data.Service.ValueString(), data.Address.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Mail Forwarder",
+			fmt.Sprintf("Could not delete mail forwarder %s on %s: %s", data.Address.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted mail forwarder", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"address": data.Address.ValueString(),
+	})
+}
+
+func (r *MailForwarderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/address
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/address', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("address"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MySQLDatabaseResource{}
+	_ resource.ResourceWithImportState = &MySQLDatabaseResource{}
+	_ resource.ResourceWithModifyPlan  = &MySQLDatabaseResource{}
+)
+
+func NewMySQLDatabaseResource() resource.Resource {
+	return &MySQLDatabaseResource{}
+}
+
+// MySQLDatabaseResource manages a MySQL database of a webhosting service
+type MySQLDatabaseResource struct {
+	client *Client
+}
+
+type MySQLDatabaseResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Service          types.String `tfsdk:"service"`
+	Name             types.String `tfsdk:"name"`
+	FullName         types.String `tfsdk:"full_name"`
+	Comment          types.String `tfsdk:"comment"`
+	Collation        types.String `tfsdk:"collation"`
+	DiskUsageHuman   types.String `tfsdk:"disk_usage_human"`
+	DiskUsageUpdated types.String `tfsdk:"disk_usage_updated"`
+}
+
+func (r *MySQLDatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_database"
+}
+
+// mysqlNamesEqual reports whether name, as given in the configuration, refers
+// to the database or account fullName. Zone.EU prefixes names with the
+// service's database prefix (the part of fullName before the first "_")
+// unless the prefix is already given.
+func mysqlNamesEqual(fullName, name string) bool {
+	prefix, _, ok := strings.Cut(fullName, "_")
+	return fullName == name || (ok && fullName == prefix+"_"+name)
+}
+
+// requiresReplaceIfMySQLNameChanged replaces the resource only if the new name
+// refers to a different database or account than the full name in state,
+// e.g. not when the prefix is added to or removed from the configuration
+func requiresReplaceIfMySQLNameChanged(fullNameAttribute string) stringplanmodifier.RequiresReplaceIfFunc {
+	return func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		var fullName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(fullNameAttribute), &fullName)...)
+		resp.RequiresReplace = !mysqlNamesEqual(fullName.ValueString(), req.PlanValue.ValueString())
+	}
+}
+
+func (r *MySQLDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a MySQL database of a Zone.EU webhosting service. Zone.EU cannot change a database after it is created, " +
+			"so changing comment or collation replaces the database and its data; consider lifecycle { prevent_destroy = true }.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/full_name'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the database. Zone.EU prefixes it with the service's database prefix unless the prefix is already included.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfMySQLNameChanged("full_name"),
+						"Changing the name to a different database requires replacement.",
+						"Changing the name to a different database requires replacement.",
+					),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Description: "The full name of the database including the prefix, as used to connect and in zoneeu_mysql_grant.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment for the database. Changing it replaces the database.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collation": schema.StringAttribute{
+				Description: "The collation of the database, checked against the collations Zone.EU offers during plan. " +
+					"Defaults to utf8mb4_unicode_ci. Changing it replaces the database.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_usage_human": schema.StringAttribute{
+				Description: "The disk usage of the database in human readable form.",
+				Computed:    true,
+			},
+			"disk_usage_updated": schema.StringAttribute{
+				Description: "When the disk usage was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MySQLDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks a configured collation against the collations Zone.EU
+// offers, so that a typo is reported during plan instead of apply
+func (r *MySQLDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan MySQLDatabaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Service.IsUnknown() || plan.Collation.IsUnknown() || plan.Collation.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state MySQLDatabaseResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Collation.Equal(plan.Collation) {
+			return
+		}
+	}
+	service := plan.Service.ValueString()
+
+	collations, err := r.client.GetMySQLCollations(service)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Collation",
+			fmt.Sprintf("Could not list the MySQL collations available on %s, the collation will only be checked during apply: %s", service, err),
+		)
+		return
+	}
+
+	if problem := checkOption("Collation", plan.Collation.ValueString(), collations); problem != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("collation"), "Invalid Collation", problem)
+	}
+}
+
+// checkOption checks a value against the values Zone.EU offers and returns a
+// description of the problem, or "" if the value is offered
+func checkOption(kind, value string, options []string) string {
+	for _, option := range options {
+		if option == value {
+			return ""
+		}
+	}
+	if len(options) == 0 {
+		return fmt.Sprintf("%s %q is not available: Zone.EU offers none.", kind, value)
+	}
+	return fmt.Sprintf("%s %q is not available, expected one of: %s.", kind, value, strings.Join(options, ", "))
+}
+
+// setMySQLDatabaseState copies the API representation of a database into the
+// model. The configured name is kept, and so is the collation if Zone.EU does
+// not return it.
+func setMySQLDatabaseState(data *MySQLDatabaseResourceModel, service string, database *MySQLDatabase) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, database.Name))
+	data.Service = types.StringValue(service)
+	data.FullName = types.StringValue(database.Name)
+	data.Comment = types.StringValue(database.Comment)
+	if database.Collation != "" || data.Collation.IsNull() || data.Collation.IsUnknown() {
+		data.Collation = types.StringValue(database.Collation)
+	}
+	data.DiskUsageHuman = types.StringValue(database.DiskUsageHuman)
+	data.DiskUsageUpdated = types.StringValue(database.DiskUsageUpdated)
+}
+
+func (r *MySQLDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MySQLDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	database := &MySQLDatabase{
+		Name:      data.Name.ValueString(),
+		Comment:   data.Comment.ValueString(),
+		Collation: data.Collation.ValueString(),
+	}
+	created, err := r.client.CreateMySQLDatabase(service, database)
+	if err != nil {
+		detail := fmt.Sprintf("Could not create MySQL database %s on %s: %s", database.Name, service, err)
+		if IsPaymentRequired(err) {
+			detail += "\n\nThe database limit of the webhosting plan has been reached."
+		}
+		addAPIError(&resp.Diagnostics, "Error Creating MySQL Database", detail, err, rootAttributes("name", "comment", "collation"))
+		return
+	}
+
+	setMySQLDatabaseState(&data, service, created)
+
+	tflog.Trace(ctx, "created MySQL database", map[string]interface{}{
+		"service": service,
+		"name":    created.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MySQLDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	database, err := r.client.GetMySQLDatabase(service, data.FullName.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading MySQL Database",
+			fmt.Sprintf("Could not read MySQL database %s on %s: %s", data.FullName.ValueString(), service, err),
+		)
+		return
+	}
+
+	setMySQLDatabaseState(&data, service, database)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles a name that still refers to the same database, every
+// other change replaces it
+func (r *MySQLDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MySQLDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	database, err := r.client.GetMySQLDatabase(service, state.FullName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading MySQL Database",
+			fmt.Sprintf("Could not read MySQL database %s on %s: %s", state.FullName.ValueString(), service, err),
+		)
+		return
+	}
+
+	setMySQLDatabaseState(&data, service, database)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MySQLDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMySQLDatabase(data.Service.ValueString(), data.FullName.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting MySQL Database",
+			fmt.Sprintf("Could not delete MySQL database %s on %s: %s", data.FullName.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted MySQL database", map[string]interface{}{
+		"service": data.Service.ValueString(),
+		"name":    data.FullName.ValueString(),
+	})
+}
+
+func (r *MySQLDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/full_name
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/full_name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
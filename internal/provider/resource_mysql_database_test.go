@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMySQLNamesEqual(t *testing.T) {
+	tests := []struct {
+		fullName string
+		name     string
+		expected bool
+	}{
+		{"d12345_shop", "shop", true},
+		{"d12345_shop", "d12345_shop", true},
+		{"d12345_shop", "hop", false},
+		{"d12345_shop", "blog", false},
+		{"d12345_my_shop", "my_shop", true},
+		{"d12345_my_shop", "shop", false},
+		{"d1234_app_db", "db", false},
+		{"shop", "shop", true},
+	}
+
+	for _, tt := range tests {
+		if got := mysqlNamesEqual(tt.fullName, tt.name); got != tt.expected {
+			t.Errorf("mysqlNamesEqual(%q, %q) = %t, expected %t", tt.fullName, tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestCheckOption(t *testing.T) {
+	collations := []string{"utf8mb4_unicode_ci", "utf8mb4_estonian_ci"}
+
+	if problem := checkOption("Collation", "utf8mb4_estonian_ci", collations); problem != "" {
+		t.Errorf("expected no problem, got %q", problem)
+	}
+	if problem := checkOption("Collation", "latin1", collations); !strings.Contains(problem, "utf8mb4_unicode_ci, utf8mb4_estonian_ci") {
+		t.Errorf("expected the available collations to be listed, got %q", problem)
+	}
+	if problem := checkOption("Permission", "SELECT", nil); !strings.Contains(problem, "offers none") {
+		t.Errorf("unexpected problem: %q", problem)
+	}
+}
+
+func TestSetMySQLDatabaseState(t *testing.T) {
+	data := MySQLDatabaseResourceModel{
+		Name:      types.StringValue("shop"),
+		Collation: types.StringValue("utf8mb4_estonian_ci"),
+	}
+
+	// Zone.EU may not return the collation, which can only be set on create
+	setMySQLDatabaseState(&data, "example.com", &MySQLDatabase{Name: "d12345_shop", DiskUsageHuman: "1 MB"})
+
+	if data.ID.ValueString() != "example.com/d12345_shop" || data.FullName.ValueString() != "d12345_shop" {
+		t.Errorf("unexpected ID or full_name: %s, %s", data.ID, data.FullName)
+	}
+	if data.Name.ValueString() != "shop" {
+		t.Errorf("expected the configured name to be kept, got %s", data.Name)
+	}
+	if data.Collation.ValueString() != "utf8mb4_estonian_ci" {
+		t.Errorf("expected the collation to be kept, got %s", data.Collation)
+	}
+
+	data.Collation = types.StringUnknown()
+	setMySQLDatabaseState(&data, "example.com", &MySQLDatabase{Name: "d12345_shop", Collation: "utf8mb4_unicode_ci"})
+	if data.Collation.ValueString() != "utf8mb4_unicode_ci" {
+		t.Errorf("expected the returned collation, got %s", data.Collation)
+	}
+}
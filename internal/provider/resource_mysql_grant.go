@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MySQLGrantResource{}
+	_ resource.ResourceWithImportState = &MySQLGrantResource{}
+	_ resource.ResourceWithModifyPlan  = &MySQLGrantResource{}
+)
+
+func NewMySQLGrantResource() resource.Resource {
+	return &MySQLGrantResource{}
+}
+
+// MySQLGrantResource manages the permissions of a MySQL account on one
+// database
+type MySQLGrantResource struct {
+	client *Client
+}
+
+type MySQLGrantResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Service     types.String `tfsdk:"service"`
+	Username    types.String `tfsdk:"username"`
+	Database    types.String `tfsdk:"database"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (r *MySQLGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_grant"
+}
+
+func (r *MySQLGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the permissions of a MySQL account on a database of a Zone.EU webhosting service. " +
+			"The permissions replace any permissions the account already has on the database.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/username/database'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The full username of the MySQL account, e.g. zoneeu_mysql_user.example.full_username.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "The full name of the database, e.g. zoneeu_mysql_database.example.full_name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The permissions granted on the database, e.g. SELECT or INSERT. " +
+					"They are checked against the permissions Zone.EU offers for the account during plan.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *MySQLGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks the permissions against the permissions Zone.EU offers
+// for the account, so that an unsupported permission is reported during plan
+// instead of apply. Accounts created in the same run are checked during
+// apply.
+func (r *MySQLGrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data MySQLGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Service.IsUnknown() || data.Username.IsUnknown() || data.Permissions.IsUnknown() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := data.Username.ValueString()
+
+	permissions, diags := grantPermissions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := r.client.GetMySQLPermissionOptions(service, username)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check MySQL Permissions",
+			fmt.Sprintf("Could not list the permissions available for %s on %s, the permissions will only be checked during apply: %s", username, service, err),
+		)
+		return
+	}
+
+	for _, permission := range permissions {
+		if problem := checkOption("Permission", permission, options); problem != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("permissions"), "Invalid MySQL Permission", problem)
+		}
+	}
+}
+
+// setMySQLGrantState copies the API representation of a grant into the model
+func setMySQLGrantState(ctx context.Context, data *MySQLGrantResourceModel, service, username, database string, permission *MySQLPermission) diag.Diagnostics {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", service, username, database))
+	data.Service = types.StringValue(service)
+	data.Username = types.StringValue(username)
+	data.Database = types.StringValue(database)
+
+	permissions := permission.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	var diags diag.Diagnostics
+	data.Permissions, diags = types.SetValueFrom(ctx, types.StringType, permissions)
+	return diags
+}
+
+// grantPermissions returns the planned permissions, sorted
+func grantPermissions(ctx context.Context, data *MySQLGrantResourceModel) ([]string, diag.Diagnostics) {
+	var permissions []string
+	diags := data.Permissions.ElementsAs(ctx, &permissions, false)
+	sort.Strings(permissions)
+	return permissions, diags
+}
+
+func (r *MySQLGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MySQLGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := data.Username.ValueString()
+	database := data.Database.ValueString()
+
+	permissions, diags := grantPermissions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.SetMySQLPermission(service, username, database, permissions)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Creating MySQL Grant",
+			fmt.Sprintf("Could not grant permissions on %s to %s on %s: %s", database, username, service, err),
+			err, rootAttributes("permissions"),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLGrantState(ctx, &data, service, username, database, permission)...)
+
+	tflog.Trace(ctx, "created MySQL grant", map[string]interface{}{
+		"service":  service,
+		"username": username,
+		"database": database,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MySQLGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := data.Username.ValueString()
+	database := data.Database.ValueString()
+
+	permission, err := r.client.GetMySQLPermission(service, username, database)
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading MySQL Grant",
+			fmt.Sprintf("Could not read permissions of %s on %s on %s: %s", username, database, service, err),
+		)
+		return
+	}
+
+	// Revoking every permission outside Terraform leaves nothing to manage
+	if len(permission.Permissions) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLGrantState(ctx, &data, service, username, database, permission)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MySQLGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := data.Username.ValueString()
+	database := data.Database.ValueString()
+
+	permissions, diags := grantPermissions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.SetMySQLPermission(service, username, database, permissions)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating MySQL Grant",
+			fmt.Sprintf("Could not update permissions of %s on %s on %s: %s", username, database, service, err),
+			err, rootAttributes("permissions"),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLGrantState(ctx, &data, service, username, database, permission)...)
+
+	tflog.Trace(ctx, "updated MySQL grant", map[string]interface{}{
+		"service":  service,
+		"username": username,
+		"database": database,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MySQLGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := data.Username.ValueString()
+	database := data.Database.ValueString()
+
+	err := r.client.DeleteMySQLPermission(service, username, database)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting MySQL Grant",
+			fmt.Sprintf("Could not revoke permissions of %s on %s on %s: %s", username, database, service, err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted MySQL grant", map[string]interface{}{
+		"service":  service,
+		"username": username,
+		"database": database,
+	})
+}
+
+func (r *MySQLGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/username/database
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/username/database', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
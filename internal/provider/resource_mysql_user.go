@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &MySQLUserResource{}
+	_ resource.ResourceWithImportState = &MySQLUserResource{}
+)
+
+func NewMySQLUserResource() resource.Resource {
+	return &MySQLUserResource{}
+}
+
+// MySQLUserResource manages a MySQL account of a webhosting service
+type MySQLUserResource struct {
+	client *Client
+}
+
+type MySQLUserResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Service      types.String `tfsdk:"service"`
+	Username     types.String `tfsdk:"username"`
+	FullUsername types.String `tfsdk:"full_username"`
+	Password     types.String `tfsdk:"password"`
+	Comment      types.String `tfsdk:"comment"`
+	RequireSSL   types.Bool   `tfsdk:"require_ssl"`
+	Hosts        types.Set    `tfsdk:"hosts"`
+}
+
+func (r *MySQLUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_user"
+}
+
+func (r *MySQLUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a MySQL account of a Zone.EU webhosting service. Grant it access to databases with zoneeu_mysql_grant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier for this resource in format 'service/full_username'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the webhosting service (e.g., example.com).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the account. Zone.EU prefixes it with the service's database prefix unless the prefix is already included.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfMySQLNameChanged("full_username"),
+						"Changing the username to a different account requires replacement.",
+						"Changing the username to a different account requires replacement.",
+					),
+				},
+			},
+			"full_username": schema.StringAttribute{
+				Description: "The full username including the prefix, as used to connect and in zoneeu_mysql_grant.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the account, 10-64 characters. Zone.EU never returns the password, so changes made outside Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(10, 64),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment for the account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_ssl": schema.BoolAttribute{
+				Description: "Whether connections must use SSL. Changing it replaces the account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				Description: "The IP addresses (IPv4 or IPv6) that may connect with the account, and `ws` (the webserver), `pma` (phpMyAdmin) or `vpn`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MySQLUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// mysqlUserAttributes maps API field names to resource attributes for
+// reporting validation errors
+var mysqlUserAttributes = rootAttributes("username", "password", "comment", "require_ssl", "hosts")
+
+// mysqlAccountFromPlan builds the request body for creating or updating an
+// account. Unknown optional attributes are left for Zone.EU to default.
+func mysqlAccountFromPlan(ctx context.Context, data *MySQLUserResourceModel) (*MySQLAccount, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	account := &MySQLAccount{
+		Username: data.Username.ValueString(),
+		Password: data.Password.ValueString(),
+		Comment:  data.Comment.ValueString(),
+	}
+	if !data.RequireSSL.IsNull() && !data.RequireSSL.IsUnknown() {
+		account.RequireSSL = data.RequireSSL.ValueBoolPointer()
+	}
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
+		diags.Append(data.Hosts.ElementsAs(ctx, &account.Hosts, false)...)
+	}
+	return account, diags
+}
+
+// setMySQLUserState copies the API representation of an account into the
+// model. The configured username and the password are left as is.
+func setMySQLUserState(ctx context.Context, data *MySQLUserResourceModel, service string, account *MySQLAccount) diag.Diagnostics {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", service, account.Username))
+	data.Service = types.StringValue(service)
+	data.FullUsername = types.StringValue(account.Username)
+	data.Comment = types.StringValue(account.Comment)
+	data.RequireSSL = types.BoolValue(account.RequireSSL != nil && *account.RequireSSL)
+
+	hosts := account.Hosts
+	if hosts == nil {
+		hosts = []string{}
+	}
+	var diags diag.Diagnostics
+	data.Hosts, diags = types.SetValueFrom(ctx, types.StringType, hosts)
+	return diags
+}
+
+func (r *MySQLUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MySQLUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	account, diags := mysqlAccountFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateMySQLAccount(service, account)
+	if err != nil {
+		detail := fmt.Sprintf("Could not create MySQL account %s on %s: %s", account.Username, service, err)
+		if IsPaymentRequired(err) {
+			detail += "\n\nThe database account limit of the webhosting plan has been reached."
+		}
+		addAPIError(&resp.Diagnostics, "Error Creating MySQL User", detail, err, mysqlUserAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLUserState(ctx, &data, service, created)...)
+
+	tflog.Trace(ctx, "created MySQL account", map[string]interface{}{
+		"service":  service,
+		"username": created.Username,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MySQLUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+
+	account, err := r.client.GetMySQLAccount(service, data.FullUsername.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading MySQL User",
+			fmt.Sprintf("Could not read MySQL account %s on %s: %s", data.FullUsername.ValueString(), service, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLUserState(ctx, &data, service, account)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MySQLUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	service := data.Service.ValueString()
+	username := state.FullUsername.ValueString()
+
+	account, diags := mysqlAccountFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The username and require_ssl cannot be updated, and the password is
+	// only sent when it changes so unrelated updates do not reset it
+	account.Username = username
+	account.RequireSSL = nil
+	if data.Password.Equal(state.Password) {
+		account.Password = ""
+	}
+
+	updated, err := r.client.UpdateMySQLAccount(service, username, account)
+	if err != nil {
+		addAPIError(&resp.Diagnostics,
+			"Error Updating MySQL User",
+			fmt.Sprintf("Could not update MySQL account %s on %s: %s", username, service, err),
+			err, mysqlUserAttributes,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMySQLUserState(ctx, &data, service, updated)...)
+
+	tflog.Trace(ctx, "updated MySQL account", map[string]interface{}{
+		"service":  service,
+		"username": updated.Username,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MySQLUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MySQLUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMySQLAccount(data.Service.ValueString(), data.FullUsername.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting MySQL User",
+			fmt.Sprintf("Could not delete MySQL account %s on %s: %s", data.FullUsername.ValueString(), data.Service.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted MySQL account", map[string]interface{}{
+		"service":  data.Service.ValueString(),
+		"username": data.FullUsername.ValueString(),
+	})
+}
+
+func (r *MySQLUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: service/full_username
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format 'service/full_username', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_username"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMySQLAccountFromPlan(t *testing.T) {
+	ctx := context.Background()
+	hosts, _ := types.SetValueFrom(ctx, types.StringType, []string{"ws", "192.0.2.10"})
+
+	account, diags := mysqlAccountFromPlan(ctx, &MySQLUserResourceModel{
+		Username:   types.StringValue("app"),
+		Password:   types.StringValue("correct-horse-battery"),
+		Comment:    types.StringUnknown(),
+		RequireSSL: types.BoolUnknown(),
+		Hosts:      hosts,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if account.Username != "app" || account.Password != "correct-horse-battery" {
+		t.Errorf("unexpected account: %+v", account)
+	}
+	if account.RequireSSL != nil {
+		t.Error("expected unknown require_ssl to be left unset")
+	}
+	if len(account.Hosts) != 2 {
+		t.Errorf("unexpected hosts: %v", account.Hosts)
+	}
+}
+
+func TestSetMySQLUserState(t *testing.T) {
+	ctx := context.Background()
+	data := MySQLUserResourceModel{
+		Username: types.StringValue("app"),
+		Password: types.StringValue("correct-horse-battery"),
+	}
+
+	diags := setMySQLUserState(ctx, &data, "example.com", &MySQLAccount{Username: "d12345_app"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.ID.ValueString() != "example.com/d12345_app" || data.FullUsername.ValueString() != "d12345_app" {
+		t.Errorf("unexpected ID or full_username: %s, %s", data.ID, data.FullUsername)
+	}
+	if data.Username.ValueString() != "app" || data.Password.ValueString() != "correct-horse-battery" {
+		t.Error("expected the configured username and password to be kept")
+	}
+	if data.RequireSSL.ValueBool() || data.Hosts.IsNull() || len(data.Hosts.Elements()) != 0 {
+		t.Errorf("unexpected require_ssl or hosts: %s, %s", data.RequireSSL, data.Hosts)
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Zone file support: rendering the records returned by the record listing
+// calls as an RFC 1035 master file (BIND syntax) and parsing such a file back
+// into records.
+//
+// URL records are a Zone.EU extension without a master-file representation.
+// They are rendered as comment lines ("; www IN URL 301 https://...") so that
+// BIND ignores them while parseZoneFile can still restore them.
+
+// txtChunkSize is the maximum length of a single <character-string>
+const txtChunkSize = 255
+
+// zoneFileHostnameTypes are the record types whose destination is a hostname
+// and is therefore written as an absolute domain name
+var zoneFileHostnameTypes = map[string]bool{
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"SRV":   true,
+}
+
+// relativeName returns the owner name of a record relative to the zone, using
+// "@" for the zone apex. Names are compared case-insensitively, and names
+// outside the zone are returned as absolute names with a trailing dot.
+func relativeName(zone, name string) string {
+	apex := canonicalRecordName(zone, "@")
+	name = canonicalRecordName(zone, name)
+	switch {
+	case name == apex:
+		return "@"
+	case strings.HasSuffix(name, "."+apex):
+		return strings.TrimSuffix(name, "."+apex)
+	default:
+		return name + "."
+	}
+}
+
+// absoluteName expands a master-file name relative to origin to an FQDN
+// without the trailing dot
+func absoluteName(origin, name string) string {
+	switch {
+	case name == "@" || name == "":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	default:
+		return name + "." + origin
+	}
+}
+
+// quoteTXT renders a TXT value as one or more quoted character-strings
+func quoteTXT(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var parts []string
+	for len(value) > txtChunkSize {
+		parts = append(parts, `"`+escaped.Replace(value[:txtChunkSize])+`"`)
+		value = value[txtChunkSize:]
+	}
+	parts = append(parts, `"`+escaped.Replace(value)+`"`)
+	return strings.Join(parts, " ")
+}
+
+// zoneFileRData renders the RDATA of a record
+func zoneFileRData(zr zoneRecord) string {
+	r := zr.Record
+	destination := r.Destination
+	if zoneFileHostnameTypes[zr.Type.Type] && !strings.HasSuffix(destination, ".") {
+		destination += "."
+	}
+
+	switch zr.Type.Type {
+	case "MX":
+		return fmt.Sprintf("%d %s", r.Priority, destination)
+	case "SRV":
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, destination)
+	case "TXT":
+		return quoteTXT(r.Destination)
+	case "CAA":
+		return fmt.Sprintf("%d %s %s", r.Flag, r.Tag, quoteTXT(r.Destination))
+	case "TLSA":
+		return fmt.Sprintf("%d %d %d %s", r.CertificateUsage, r.Selector, r.MatchingType, r.Destination)
+	case "SSHFP":
+		return fmt.Sprintf("%d %d %s", r.Algorithm, r.Type, r.Destination)
+	case "URL":
+		return fmt.Sprintf("%d %s", r.Type, r.Destination)
+	default:
+		return destination
+	}
+}
+
+// renderZoneFile renders records as a master file for zone. If ttl is greater
+// than zero a $TTL directive is included. Records are sorted by owner name and
+// type so the output is stable and can be diffed.
+func renderZoneFile(zone string, records []zoneRecord, ttl int) string {
+	sorted := make([]zoneRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ni, nj := relativeName(zone, sorted[i].Record.Name), relativeName(zone, sorted[j].Record.Name)
+		if ni != nj {
+			// Keep the apex first
+			if ni == "@" || nj == "@" {
+				return ni == "@"
+			}
+			return ni < nj
+		}
+		return sorted[i].Type.Type < sorted[j].Type.Type
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", zone)
+	if ttl > 0 {
+		fmt.Fprintf(&b, "$TTL %d\n", ttl)
+	}
+
+	var urlRecords []zoneRecord
+	for _, zr := range sorted {
+		if zr.Type.Type == "URL" {
+			urlRecords = append(urlRecords, zr)
+			continue
+		}
+		fmt.Fprintf(&b, "%s\tIN\t%s\t%s\n", relativeName(zone, zr.Record.Name), zr.Type.Type, zoneFileRData(zr))
+	}
+
+	if len(urlRecords) > 0 {
+		b.WriteString("\n; Zone.EU URL redirect records (not part of the DNS zone)\n")
+		for _, zr := range urlRecords {
+			fmt.Fprintf(&b, "; %s\tIN\tURL\t%s\n", relativeName(zone, zr.Record.Name), zoneFileRData(zr))
+		}
+	}
+
+	return b.String()
+}
+
+// tokenizeZoneFileLine splits a master-file line into fields, keeping quoted
+// strings (without the quotes) as single fields. It returns the fields and the
+// comment text, if any.
+func tokenizeZoneFileLine(line string) (fields []string, quoted []bool, comment string, err error) {
+	var current strings.Builder
+	inField, inQuotes := false, false
+
+	flush := func(wasQuoted bool) {
+		fields = append(fields, current.String())
+		quoted = append(quoted, wasQuoted)
+		current.Reset()
+		inField = false
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case inQuotes && c == '\\' && i+1 < len(line):
+			i++
+			current.WriteByte(line[i])
+		case inQuotes && c == '"':
+			inQuotes = false
+			flush(true)
+		case inQuotes:
+			current.WriteByte(c)
+		case c == '"':
+			if inField {
+				flush(false)
+			}
+			inQuotes = true
+			inField = true
+		case c == ';':
+			if inField {
+				flush(false)
+			}
+			return fields, quoted, line[i+1:], nil
+		case c == ' ' || c == '\t':
+			if inField {
+				flush(false)
+			}
+		default:
+			current.WriteByte(c)
+			inField = true
+		}
+	}
+	if inQuotes {
+		return nil, nil, "", fmt.Errorf("unterminated quoted string")
+	}
+	if inField {
+		flush(false)
+	}
+	return fields, quoted, "", nil
+}
+
+// isZoneFileClass reports whether a field is a DNS class
+func isZoneFileClass(field string) bool {
+	switch strings.ToUpper(field) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// zoneFileParser holds the state carried between master-file lines
+type zoneFileParser struct {
+	origin    string
+	lastOwner string
+}
+
+// parseRecord parses the fields of a single resource record. A leading
+// whitespace on the original line means the owner is inherited.
+func (p *zoneFileParser) parseRecord(fields []string, quoted []bool, inheritOwner bool) (*zoneRecord, error) {
+	owner := p.lastOwner
+	if !inheritOwner {
+		owner = absoluteName(p.origin, fields[0])
+		fields, quoted = fields[1:], quoted[1:]
+	}
+	p.lastOwner = owner
+
+	// Optional TTL and class, in either order
+	for len(fields) > 0 && !quoted[0] {
+		if _, err := strconv.Atoi(fields[0]); err == nil || isZoneFileClass(fields[0]) {
+			fields, quoted = fields[1:], quoted[1:]
+			continue
+		}
+		break
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("missing record type for %s", owner)
+	}
+
+	recordType := strings.ToUpper(fields[0])
+	rdata, rquoted := fields[1:], quoted[1:]
+	if recordType == "SOA" {
+		return nil, nil // managed by Zone.EU
+	}
+	rt := dnsRecordTypeByName(recordType)
+	if rt == nil {
+		return nil, fmt.Errorf("unsupported record type %s for %s", recordType, owner)
+	}
+
+	ints := func(n int) ([]int, error) {
+		if len(rdata) < n {
+			return nil, fmt.Errorf("%s record for %s: expected at least %d fields, got %d", recordType, owner, n+1, len(rdata))
+		}
+		values := make([]int, n)
+		for i := 0; i < n; i++ {
+			v, err := strconv.Atoi(rdata[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s record for %s: invalid number %q", recordType, owner, rdata[i])
+			}
+			values[i] = v
+		}
+		return values, nil
+	}
+	hostname := func(i int) (string, error) {
+		if len(rdata) <= i {
+			return "", fmt.Errorf("%s record for %s: missing target", recordType, owner)
+		}
+		return absoluteName(p.origin, rdata[i]), nil
+	}
+
+	record := DNSRecord{Name: owner}
+	var err error
+	switch recordType {
+	case "MX":
+		var v []int
+		if v, err = ints(1); err == nil {
+			record.Priority = v[0]
+			record.Destination, err = hostname(1)
+		}
+	case "SRV":
+		var v []int
+		if v, err = ints(3); err == nil {
+			record.Priority, record.Weight, record.Port = v[0], v[1], v[2]
+			record.Destination, err = hostname(3)
+		}
+	case "CNAME", "NS":
+		record.Destination, err = hostname(0)
+	case "TXT":
+		record.Destination = strings.Join(rdata, "")
+		if len(rdata) > 0 && !rquoted[0] {
+			record.Destination = strings.Join(rdata, " ")
+		}
+	case "CAA":
+		var v []int
+		if v, err = ints(1); err == nil {
+			if len(rdata) < 3 {
+				err = fmt.Errorf("CAA record for %s: expected flag, tag and value", owner)
+			} else {
+				record.Flag, record.Tag, record.Destination = v[0], rdata[1], strings.Join(rdata[2:], "")
+			}
+		}
+	case "TLSA":
+		var v []int
+		if v, err = ints(3); err == nil {
+			record.CertificateUsage, record.Selector, record.MatchingType = v[0], v[1], v[2]
+			record.Destination = strings.Join(rdata[3:], "")
+		}
+	case "SSHFP":
+		var v []int
+		if v, err = ints(2); err == nil {
+			record.Algorithm, record.Type = v[0], v[1]
+			record.Destination = strings.Join(rdata[2:], "")
+		}
+	case "URL":
+		var v []int
+		if v, err = ints(1); err == nil {
+			record.Type = v[0]
+			record.Destination = strings.Join(rdata[1:], "")
+		}
+	default:
+		if len(rdata) == 0 {
+			err = fmt.Errorf("%s record for %s: missing value", recordType, owner)
+		} else {
+			record.Destination = rdata[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &zoneRecord{Type: rt, Record: record}, nil
+}
+
+// parseZoneFile parses a master file for zone into records. Record names are
+// returned as FQDNs without the trailing dot. SOA records are skipped; other
+// record types that Zone.EU does not support result in an error.
+func parseZoneFile(zone, content string) ([]zoneRecord, error) {
+	p := &zoneFileParser{origin: strings.TrimSuffix(zone, "."), lastOwner: zone}
+
+	var records []zoneRecord
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		inheritOwner := len(line) > 0 && (line[0] == ' ' || line[0] == '\t')
+
+		fields, quoted, comment, err := tokenizeZoneFileLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+
+		// Join parenthesized multi-line records
+		for containsUnclosedParen(fields, quoted) && scanner.Scan() {
+			lineNo++
+			more, moreQuoted, _, err := tokenizeZoneFileLine(scanner.Text())
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			fields, quoted = append(fields, more...), append(quoted, moreQuoted...)
+		}
+		fields, quoted = stripParens(fields, quoted)
+
+		if len(fields) == 0 {
+			// Commented-out URL records written by renderZoneFile
+			commentFields, commentQuoted, _, err := tokenizeZoneFileLine(strings.TrimSpace(comment))
+			if err == nil && len(commentFields) >= 4 && strings.EqualFold(commentFields[2], "URL") {
+				zr, err := p.parseRecord(commentFields, commentQuoted, false)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNo, err)
+				}
+				records = append(records, *zr)
+			}
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN without a name", lineNo)
+			}
+			p.origin = absoluteName(p.origin, fields[1])
+			continue
+		case "$TTL", "$INCLUDE", "$GENERATE":
+			continue
+		}
+
+		zr, err := p.parseRecord(fields, quoted, inheritOwner)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if zr != nil {
+			records = append(records, *zr)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// containsUnclosedParen reports whether an unquoted "(" is not followed by ")"
+func containsUnclosedParen(fields []string, quoted []bool) bool {
+	depth := 0
+	for i, f := range fields {
+		if quoted[i] {
+			continue
+		}
+		depth += strings.Count(f, "(") - strings.Count(f, ")")
+	}
+	return depth > 0
+}
+
+// stripParens removes unquoted grouping parentheses
+func stripParens(fields []string, quoted []bool) ([]string, []bool) {
+	var outFields []string
+	var outQuoted []bool
+	for i, f := range fields {
+		if !quoted[i] {
+			f = strings.NewReplacer("(", "", ")", "").Replace(f)
+			if f == "" {
+				continue
+			}
+		}
+		outFields = append(outFields, f)
+		outQuoted = append(outQuoted, quoted[i])
+	}
+	return outFields, outQuoted
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderZoneFile(t *testing.T) {
+	records := []zoneRecord{
+		{Type: dnsRecordTypeCNAME, Record: DNSRecord{Name: "www", Destination: "example.com"}},
+		{Type: dnsRecordTypeA, Record: DNSRecord{Name: "example.com", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeMX, Record: DNSRecord{Name: "example.com", Destination: "mail.example.com", Priority: 10}},
+		{Type: dnsRecordTypeTXT, Record: DNSRecord{Name: "example.com", Destination: `v=spf1 "quoted" ~all`}},
+		{Type: dnsRecordTypeURL, Record: DNSRecord{Name: "old.example.com", Destination: "https://new.example.com", Type: 301}},
+	}
+
+	expected := `$ORIGIN example.com.
+$TTL 3600
+@	IN	A	192.0.2.1
+@	IN	MX	10 mail.example.com.
+@	IN	TXT	"v=spf1 \"quoted\" ~all"
+www	IN	CNAME	example.com.
+
+; Zone.EU URL redirect records (not part of the DNS zone)
+; old	IN	URL	301 https://new.example.com
+`
+	if got := renderZoneFile("example.com", records, 3600); got != expected {
+		t.Errorf("unexpected zone file:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestParseZoneFile(t *testing.T) {
+	content := `$ORIGIN example.com.
+$TTL 3600
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2024010101 ; serial
+		3600 900 604800 300 )
+@	3600	IN	A	192.0.2.1
+	IN	MX	10 mail
+_sip._tcp	IN	SRV	10 5 5060 sip.example.net.
+@	IN	CAA	0 issue "letsencrypt.org"
+dkim._domainkey	IN	TXT	"v=DKIM1; k=rsa; " "p=MIGf"
+; old	IN	URL	302 https://new.example.com
+`
+	records, err := parseZoneFile("example.com", content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 6 {
+		t.Fatalf("expected 6 records, got %d: %+v", len(records), records)
+	}
+
+	mx := records[1]
+	if mx.Type != dnsRecordTypeMX || mx.Record.Name != "example.com" || mx.Record.Destination != "mail.example.com" || mx.Record.Priority != 10 {
+		t.Errorf("unexpected MX record: %+v", mx.Record)
+	}
+	srv := records[2]
+	if srv.Record.Name != "_sip._tcp.example.com" || srv.Record.Port != 5060 || srv.Record.Destination != "sip.example.net" {
+		t.Errorf("unexpected SRV record: %+v", srv.Record)
+	}
+	txt := records[4]
+	if txt.Record.Destination != "v=DKIM1; k=rsa; p=MIGf" {
+		t.Errorf("unexpected TXT value: %q", txt.Record.Destination)
+	}
+	url := records[5]
+	if url.Type != dnsRecordTypeURL || url.Record.Type != 302 || url.Record.Name != "old.example.com" {
+		t.Errorf("unexpected URL record: %+v", url.Record)
+	}
+}
+
+func TestZoneFileRoundTrip(t *testing.T) {
+	records := []zoneRecord{
+		{Type: dnsRecordTypeA, Record: DNSRecord{Name: "example.com", Destination: "192.0.2.1"}},
+		{Type: dnsRecordTypeNS, Record: DNSRecord{Name: "sub.example.com", Destination: "ns1.example.net"}},
+		{Type: dnsRecordTypeTXT, Record: DNSRecord{Name: "long.example.com", Destination: strings.Repeat("a", 300)}},
+		{Type: dnsRecordTypeTLSA, Record: DNSRecord{Name: "_443._tcp.example.com", Destination: "abcdef", CertificateUsage: 3, Selector: 1, MatchingType: 1}},
+		{Type: dnsRecordTypeSSHFP, Record: DNSRecord{Name: "server.example.com", Destination: "123456", Algorithm: 4, Type: 2}},
+		{Type: dnsRecordTypeURL, Record: DNSRecord{Name: "old.example.com", Destination: "https://new.example.com", Type: 301}},
+	}
+
+	parsed, err := parseZoneFile("example.com", renderZoneFile("example.com", records, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parsed) != len(records) {
+		t.Fatalf("expected %d records, got %d", len(records), len(parsed))
+	}
+
+	keys := map[string]int{}
+	for _, zr := range records {
+		keys[zr.Type.recordKey("example.com", &zr.Record)]++
+	}
+	for _, zr := range parsed {
+		key := zr.Type.recordKey("example.com", &zr.Record)
+		if keys[key] == 0 {
+			t.Errorf("unexpected record after round trip: %s", key)
+		}
+		keys[key]--
+	}
+}
+
+func TestRelativeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"", "@"},
+		{"@", "@"},
+		{"example.com", "@"},
+		{"Example.COM.", "@"},
+		{"www", "www"},
+		{"www.example.com", "www"},
+		{"WWW.Example.Com", "www"},
+		{"_sip._tcp.example.com.", "_sip._tcp"},
+		{"www.example.org.", "www.example.org."},
+		{"notexample.com.", "notexample.com."},
+	}
+	for _, tt := range tests {
+		if got := relativeName("example.com", tt.name); got != tt.expected {
+			t.Errorf("relativeName(%q) = %q, expected %q", tt.name, got, tt.expected)
+		}
+	}
+}